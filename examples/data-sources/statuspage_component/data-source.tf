@@ -0,0 +1,8 @@
+data "statuspage_component" "api" {
+  page_id = "my_page_id"
+  name    = "API"
+}
+
+output "api_status" {
+  value = data.statuspage_component.api.status
+}
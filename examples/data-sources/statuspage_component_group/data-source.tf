@@ -0,0 +1,18 @@
+data "statuspage_component_group" "product" {
+  page_id      = "my_page_id"
+  name         = "My Product"
+  uptime_start = "2024-01-01"
+  uptime_end   = "2024-03-31"
+}
+
+output "product_status" {
+  value = data.statuspage_component_group.product.status
+}
+
+output "product_uptime" {
+  value = data.statuspage_component_group.product.uptime[0].uptime_percentage
+}
+
+output "product_component_statuses" {
+  value = { for c in data.statuspage_component_group.product.components : c.name => c.status }
+}
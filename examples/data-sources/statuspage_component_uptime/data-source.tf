@@ -0,0 +1,10 @@
+data "statuspage_component_uptime" "api" {
+  page_id      = "my_page_id"
+  component_id = "my_component_id"
+  start        = "2024-01-01"
+  end          = "2024-03-31"
+}
+
+output "api_uptime" {
+  value = data.statuspage_component_uptime.api.uptime_percentage
+}
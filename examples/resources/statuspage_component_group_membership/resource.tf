@@ -0,0 +1,17 @@
+resource "statuspage_component_group" "my_group" {
+  page_id     = "my_page_id"
+  name        = "My Product"
+  description = "All components belonging to My Product"
+  components  = [statuspage_component.api.id]
+
+  # Members are added by statuspage_component_group_membership resources
+  lifecycle {
+    ignore_changes = [components]
+  }
+}
+
+resource "statuspage_component_group_membership" "dashboard" {
+  page_id      = "my_page_id"
+  group_id     = statuspage_component_group.my_group.id
+  component_id = statuspage_component.dashboard.id
+}
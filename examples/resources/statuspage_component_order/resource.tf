@@ -0,0 +1,10 @@
+resource "statuspage_component_order" "my_page" {
+  page_id = "my_page_id"
+
+  # Components and component groups, from top to bottom of the page
+  components = [
+    statuspage_component.website.id,
+    statuspage_component_group.backend.id,
+    statuspage_component.status_api.id,
+  ]
+}
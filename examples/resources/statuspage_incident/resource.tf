@@ -33,23 +33,3 @@ resource "statuspage_incident" "outage" {
     status = "degraded_performance"
   }
 }
-
-# Scheduled maintenance window
-resource "statuspage_incident" "maintenance" {
-  page_id = "my_page_id"
-
-  name            = "Scheduled database maintenance"
-  status          = "scheduled"
-  impact_override = "maintenance"
-  body            = "We will be performing routine database maintenance during this window. Expect brief interruptions."
-
-  scheduled_remind_prior    = true
-  scheduled_auto_in_progress = true
-  scheduled_auto_completed  = true
-
-  component {
-    id     = statuspage_component.api.id
-    name   = statuspage_component.api.name
-    status = "under_maintenance"
-  }
-}
\ No newline at end of file
@@ -0,0 +1,38 @@
+resource "statuspage_incident" "outage" {
+  page_id = "my_page_id"
+
+  name   = "API degraded performance"
+  status = "investigating"
+  body   = "We are currently investigating reports of degraded performance."
+
+  # The status of the incident is moved by its updates
+  lifecycle {
+    ignore_changes = [status, component]
+  }
+}
+
+resource "statuspage_incident_update" "identified" {
+  page_id     = "my_page_id"
+  incident_id = statuspage_incident.outage.id
+  status      = "identified"
+  body        = "The issue has been identified and a fix is being deployed."
+
+  component {
+    id     = statuspage_component.api.id
+    status = "partial_outage"
+  }
+}
+
+resource "statuspage_incident_update" "resolved" {
+  page_id     = "my_page_id"
+  incident_id = statuspage_incident.outage.id
+  status      = "resolved"
+  body        = "The fix has been deployed and the API is operating normally."
+
+  component {
+    id     = statuspage_component.api.id
+    status = "operational"
+  }
+
+  depends_on = [statuspage_incident_update.identified]
+}
@@ -0,0 +1,25 @@
+resource "statuspage_component" "api" {
+  page_id     = "my_page_id"
+  name        = "API"
+  description = "Core API availability"
+}
+
+resource "statuspage_scheduled_maintenance" "database" {
+  page_id = "my_page_id"
+
+  name            = "Scheduled database maintenance"
+  scheduled_for   = "2024-06-01T22:00:00Z"
+  scheduled_until = "2024-06-02T02:00:00Z"
+  body            = "We will be performing routine database maintenance during this window. Expect brief interruptions."
+
+  scheduled_remind_prior = true
+
+  scheduled_auto_in_progress                     = true
+  scheduled_auto_completed                       = true
+  auto_transition_to_maintenance_state           = true
+  auto_transition_to_operational_state           = true
+  auto_transition_deliver_notifications_at_start = true
+  auto_transition_deliver_notifications_at_end   = true
+
+  component_ids = [statuspage_component.api.id]
+}
@@ -0,0 +1,9 @@
+# The component must first be added to the page from the third-party
+# components catalog of the Status Page UI.
+resource "statuspage_third_party_component" "github_actions" {
+  page_id = "my_page_id"
+  name    = "GitHub Actions"
+
+  group_id              = statuspage_component_group.dependencies.id
+  only_show_if_degraded = true
+}
@@ -0,0 +1,163 @@
+package statuspage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func dataSourceComponent() *schema.Resource {
+	return &schema.Resource{
+		Description: "",
+		ReadContext: dataSourceComponentRead,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Description:  "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"page_name": {
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"page_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+			"id": {
+				Description:  "the ID of the component",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name": {
+				Description:  "the name of the component",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"showcase": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"only_show_if_degraded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"automation_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	pageID := d.Get("page_id").(string)
+
+	var component *sp.Component
+	if id, ok := d.GetOk("id"); ok {
+		res, httpResp, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(authV1, pageID, id.(string)).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				return diag.Errorf("no component found with ID %q in page %s", id, pageID)
+			}
+			return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component using Status Page API")
+		}
+		component = &res
+	} else {
+		res, diags := findComponentByName(ctx, m, pageID, d.Get("name").(string))
+		if diags.HasError() {
+			return diags
+		}
+		component = res
+	}
+
+	d.SetId(component.GetId())
+	for k, v := range flattenComponent(*component) {
+		if k == "id" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// flattenComponent returns the attributes of a component shared by the
+// component data sources.
+func flattenComponent(c sp.Component) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    c.GetId(),
+		"name":                  c.GetName(),
+		"description":           c.GetDescription(),
+		"status":                c.GetStatus(),
+		"group_id":              c.GetGroupId(),
+		"position":              c.GetPosition(),
+		"showcase":              c.GetShowcase(),
+		"only_show_if_degraded": c.GetOnlyShowIfDegraded(),
+		"automation_email":      c.GetAutomationEmail(),
+		"start_date":            normalizeDate(c.GetStartDate()),
+		"created_at":            formatTime(c.GetCreatedAt()),
+		"updated_at":            formatTime(c.GetUpdatedAt()),
+	}
+}
+
+// formatTime returns a timestamp of the API in RFC 3339 format, or an empty
+// string when it is not set.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
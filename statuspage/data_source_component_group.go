@@ -0,0 +1,259 @@
+package statuspage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func dataSourceComponentGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "",
+		ReadContext: dataSourceComponentGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Description:  "the ID of the page this component group belongs to. Defaults to the page_id of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"page_name": {
+				Description:   "the name of the page this component group belongs to, as an alternative to page_id",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"page_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+			"id": {
+				Description:  "the ID of the component group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name": {
+				Description:  "the name of the component group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"include_uptime": {
+				Description: "read the uptime of the group. Implied when uptime_start or uptime_end is set",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"uptime_start": {
+				Description:  "the first day of the range of the uptime, in YYYY-MM-DD format. Defaults to the range chosen by the API",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			"uptime_end": {
+				Description:  "the last day of the range of the uptime, in YYYY-MM-DD format. Defaults to the range chosen by the API",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"component_ids": {
+				Description: "the IDs of the components in the group, in the order of the group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Description: "the worst status of the components in the group, or an empty string when it has none. A status unknown to the provider ranks as the worst",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"uptime": {
+				Description: "the uptime of the group, only read when include_uptime, uptime_start or uptime_end is set",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: uptimeSchema(),
+				},
+			},
+			"components": {
+				Description: "the components in the group, in the order of the group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"position": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComponentGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	start, startOk := parseDate(d.Get("uptime_start").(string))
+	end, endOk := parseDate(d.Get("uptime_end").(string))
+	if startOk && endOk && start.After(end) {
+		return diag.Errorf("uptime_start (%s) must not be after uptime_end (%s)", d.Get("uptime_start"), d.Get("uptime_end"))
+	}
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	pageID := d.Get("page_id").(string)
+
+	var group *sp.GroupComponent
+	if id, ok := d.GetOk("id"); ok {
+		res, httpResp, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(authV1, pageID, id.(string)).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				return diag.Errorf("no component group found with ID %q in page %s", id, pageID)
+			}
+			return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component group using Status Page API")
+		}
+		group = &res
+	} else {
+		res, diags := findComponentGroupByName(ctx, m, pageID, d.Get("name").(string))
+		if diags.HasError() {
+			return diags
+		}
+		group = res
+	}
+
+	components, diags := listComponents(ctx, m, pageID)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, hasStart := d.GetOk("uptime_start")
+	_, hasEnd := d.GetOk("uptime_end")
+	uptimes := []interface{}{}
+	if d.Get("include_uptime").(bool) || hasStart || hasEnd {
+		req := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsIdUptime(authV1, pageID, group.GetId())
+		if start, ok := d.GetOk("uptime_start"); ok {
+			req = req.Start(start.(string))
+		}
+		if end, ok := d.GetOk("uptime_end"); ok {
+			req = req.End(end.(string))
+		}
+
+		uptime, httpResp, err := req.Execute()
+		if err != nil {
+			return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component group uptime using Status Page API")
+		}
+		uptimes = append(uptimes, flattenUptime(&uptime))
+	}
+
+	members := flattenGroupMembers(group.GetComponents(), components)
+
+	d.SetId(group.GetId())
+	d.Set("name", group.GetName())
+	d.Set("description", group.GetDescription())
+	d.Set("position", group.GetPosition())
+	d.Set("component_ids", group.GetComponents())
+	d.Set("status", worstComponentStatus(members))
+	if err := d.Set("components", members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("uptime", uptimes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenGroupMembers returns the details of the members of a component group
+// from the components of its page. Members missing from the page, e.g. deleted
+// since the group was read, are left out.
+func flattenGroupMembers(ids []string, components []sp.Component) []map[string]interface{} {
+	byID := make(map[string]sp.Component, len(components))
+	for _, c := range components {
+		byID[c.GetId()] = c
+	}
+
+	members := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		c, ok := byID[id]
+		if !ok {
+			continue
+		}
+		members = append(members, map[string]interface{}{
+			"id":       c.GetId(),
+			"name":     c.GetName(),
+			"status":   c.GetStatus(),
+			"position": c.GetPosition(),
+		})
+	}
+	return members
+}
+
+// componentStatusSeverity ranks the statuses of a component from the best to
+// the worst.
+var componentStatusSeverity = map[string]int{
+	"operational":          0,
+	"under_maintenance":    1,
+	"degraded_performance": 2,
+	"partial_outage":       3,
+	"major_outage":         4,
+}
+
+// componentStatusRank returns the severity of a component status. A status
+// unknown to the provider ranks as the worst, so that it is not hidden behind
+// operational members.
+func componentStatusRank(status string) int {
+	if rank, ok := componentStatusSeverity[status]; ok {
+		return rank
+	}
+	return len(componentStatusSeverity)
+}
+
+// worstComponentStatus returns the worst status of the members of a component
+// group, as returned by flattenGroupMembers.
+func worstComponentStatus(members []map[string]interface{}) string {
+	worst := ""
+	for _, m := range members {
+		status := m["status"].(string)
+		if worst == "" || componentStatusRank(status) > componentStatusRank(worst) {
+			worst = status
+		}
+	}
+	return worst
+}
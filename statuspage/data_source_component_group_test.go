@@ -0,0 +1,129 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func TestAccStatuspageComponentGroupDatasource(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceStatuspageComponentGroupConfig(rid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.statuspage_component_group.by_id", "name", "statuspage_component_group.default", "name"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_id", "description", "Acc. Tests"),
+					resource.TestCheckResourceAttrPair("data.statuspage_component_group.by_name", "id", "statuspage_component_group.default", "id"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_name", "component_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_name", "components.#", "1"),
+					resource.TestCheckResourceAttrPair("data.statuspage_component_group.by_name", "components.0.name", "statuspage_component.component_1", "name"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_name", "components.0.status", "operational"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_name", "status", "operational"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_id", "uptime.#", "0"),
+					resource.TestCheckResourceAttr("data.statuspage_component_group.by_name", "uptime.#", "1"),
+					resource.TestCheckResourceAttrSet("data.statuspage_component_group.by_name", "uptime.0.uptime_percentage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceStatuspageComponentGroupConfig(uniq int) string {
+	return fmt.Sprintf(`
+	%s
+	data "statuspage_component_group" "by_id" {
+		page_id = var.pageid
+		id      = statuspage_component_group.default.id
+	}
+	data "statuspage_component_group" "by_name" {
+		page_id        = var.pageid
+		name           = statuspage_component_group.default.name
+		include_uptime = true
+	}`, testAccStatuspageComponentGroupConfig(uniq))
+}
+
+func TestUnitFlattenGroupMembers(t *testing.T) {
+
+	component := func(id, name, status string, position int32) sp.Component {
+		var c sp.Component
+		c.SetId(id)
+		c.SetName(name)
+		c.SetStatus(status)
+		c.SetPosition(position)
+		return c
+	}
+	components := []sp.Component{
+		component("a", "API", "operational", 1),
+		component("b", "Dashboard", "major_outage", 2),
+		component("c", "Other", "operational", 3),
+	}
+
+	got := flattenGroupMembers([]string{"b", "a", "deleted"}, components)
+	want := []map[string]interface{}{
+		{"id": "b", "name": "Dashboard", "status": "major_outage", "position": int32(2)},
+		{"id": "a", "name": "API", "status": "operational", "position": int32(1)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenGroupMembers() = %v, want %v", got, want)
+	}
+}
+
+func TestUnitWorstComponentStatus(t *testing.T) {
+
+	members := func(statuses ...string) []map[string]interface{} {
+		var m []map[string]interface{}
+		for _, s := range statuses {
+			m = append(m, map[string]interface{}{"status": s})
+		}
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		members []map[string]interface{}
+		want    string
+	}{
+		{name: "empty", members: nil, want: ""},
+		{name: "operational", members: members("operational", "operational"), want: "operational"},
+		{name: "maintenance", members: members("operational", "under_maintenance"), want: "under_maintenance"},
+		{name: "outage", members: members("partial_outage", "major_outage", "degraded_performance"), want: "major_outage"},
+		{name: "degraded", members: members("degraded_performance", "under_maintenance", "operational"), want: "degraded_performance"},
+		{name: "unknownLast", members: members("operational", "major_outage", "unknown_status"), want: "unknown_status"},
+		{name: "unknownFirst", members: members("unknown_status", "operational"), want: "unknown_status"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := worstComponentStatus(tt.members); got != tt.want {
+				t.Errorf("worstComponentStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitComponentGroupUptimeRange(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, dataSourceComponentGroup().Schema, map[string]interface{}{
+		"page_id":      "page",
+		"id":           "group",
+		"uptime_start": "2024-02-01",
+		"uptime_end":   "2024-01-01",
+	})
+	diags := dataSourceComponentGroupRead(context.Background(), d, nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "must not be after uptime_end") {
+		t.Errorf("dataSourceComponentGroupRead() = %v, want a range error", diags)
+	}
+}
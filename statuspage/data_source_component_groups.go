@@ -1,6 +1,9 @@
 package statuspage
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -8,16 +11,24 @@ import (
 func dataSourceComponentGroups() *schema.Resource {
 	return &schema.Resource{
 		Description: "",
-		Read:        dataSourceComponentGroupsRead,
+		ReadContext: dataSourceComponentGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 			"page_id": {
-				Description:  "the ID of the page this component belongs to",
+				Description:  "the ID of the page this component belongs to. Defaults to the page_id of the provider",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"page_name": {
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"page_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
 			// Computed values
 			"component_groups": {
 				Type:     schema.TypeList,
@@ -59,16 +70,15 @@ func dataSourceComponentGroups() *schema.Resource {
 	}
 }
 
-func dataSourceComponentGroupsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceComponentGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	providerConf := m.(*ProviderConfiguration)
-	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
-
-	res, _, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroups(authV1, d.Get("page_id").(string)).Page(1).PerPage(100).Execute()
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
-	if err != nil {
-		return TranslateClientErrorDiag(err, "error querying component group list")
+	res, diags := listComponentGroups(ctx, m, d.Get("page_id").(string))
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(GenerateDataSourceHashID("DataSourceComponentGroups-", dataSourceComponentGroups(), d))
@@ -93,7 +103,7 @@ func dataSourceComponentGroupsRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	if err := d.Set("component_groups", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -0,0 +1,68 @@
+package statuspage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func TestAccStatuspageComponentDatasource(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceStatuspageComponentConfig(rid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.statuspage_component.by_id", "name", "statuspage_component.default", "name"),
+					resource.TestCheckResourceAttr("data.statuspage_component.by_id", "status", "operational"),
+					resource.TestCheckResourceAttrPair("data.statuspage_component.by_name", "id", "statuspage_component.default", "id"),
+					resource.TestCheckResourceAttr("data.statuspage_component.by_name", "description", "Test component 1"),
+					resource.TestCheckResourceAttrSet("data.statuspage_component.by_name", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceStatuspageComponentConfig(uniq int) string {
+	return fmt.Sprintf(`
+	%s
+	data "statuspage_component" "by_id" {
+		page_id = var.pageid
+		id      = statuspage_component.default.id
+	}
+	data "statuspage_component" "by_name" {
+		page_id = var.pageid
+		name    = statuspage_component.default.name
+	}`, testAccStatuspageComponentsConfig(uniq))
+}
+
+func TestUnitFlattenComponent(t *testing.T) {
+
+	var c sp.Component
+	c.SetId("id")
+	c.SetName("API")
+	c.SetStatus("major_outage")
+	c.SetCreatedAt(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	got := flattenComponent(c)
+
+	if got["id"] != "id" || got["name"] != "API" || got["status"] != "major_outage" {
+		t.Errorf("flattenComponent() = %v", got)
+	}
+	if got["created_at"] != "2024-01-02T03:04:05Z" {
+		t.Errorf("flattenComponent() created_at = %v, want 2024-01-02T03:04:05Z", got["created_at"])
+	}
+	if got["updated_at"] != "" {
+		t.Errorf("flattenComponent() updated_at = %v, want empty", got["updated_at"])
+	}
+}
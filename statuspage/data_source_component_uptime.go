@@ -0,0 +1,193 @@
+package statuspage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// uptime is the uptime of a component or of a component group.
+type uptime interface {
+	GetRangeStart() string
+	GetRangeEnd() string
+	GetUptimePercentage() float32
+	GetMajorOutage() int32
+	GetPartialOutage() int32
+	GetWarnings() []string
+	GetRelatedEvents() []sp.ComponentUptimeRelatedEvents
+}
+
+// uptimeSchema returns the computed attributes of an uptime.
+func uptimeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"range_start": {
+			Description: "the first day of the range of the uptime",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"range_end": {
+			Description: "the last day of the range of the uptime",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"uptime_percentage": {
+			Description: "the uptime over the range, in percent",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"major_outage": {
+			Description: "the time spent in major outage over the range, in seconds",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"partial_outage": {
+			Description: "the time spent in partial outage over the range, in seconds",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"warnings": {
+			Description: "the warnings of the API about the range, e.g. when it was truncated",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"related_events": {
+			Description: "the incidents which affected the uptime over the range",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// setUptime records an uptime in the attributes of uptimeSchema.
+func setUptime(d *schema.ResourceData, u uptime) diag.Diagnostics {
+	for k, v := range flattenUptime(u) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// flattenUptime returns the attributes of uptimeSchema for an uptime.
+func flattenUptime(u uptime) map[string]interface{} {
+	events := make([]map[string]interface{}, 0, len(u.GetRelatedEvents()))
+	for _, e := range u.GetRelatedEvents() {
+		events = append(events, map[string]interface{}{
+			"id":   e.GetId(),
+			"name": e.GetName(),
+		})
+	}
+
+	return map[string]interface{}{
+		"range_start":       u.GetRangeStart(),
+		"range_end":         u.GetRangeEnd(),
+		"uptime_percentage": float64(u.GetUptimePercentage()),
+		"major_outage":      u.GetMajorOutage(),
+		"partial_outage":    u.GetPartialOutage(),
+		"warnings":          u.GetWarnings(),
+		"related_events":    events,
+	}
+}
+
+func dataSourceComponentUptime() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"page_id": {
+			Description:  "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"page_name": {
+			Description:   "the name of the page this component belongs to, as an alternative to page_id",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"page_id"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+		"component_id": {
+			Description:  "the ID of the component",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"start": {
+			Description:  "the first day of the range, in YYYY-MM-DD format. Defaults to the range chosen by the API",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDate,
+		},
+		"end": {
+			Description:  "the last day of the range, in YYYY-MM-DD format. Defaults to the range chosen by the API",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDate,
+		},
+		// Computed values
+		"name": {
+			Description: "the name of the component",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for k, v := range uptimeSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Description: "",
+		ReadContext: dataSourceComponentUptimeRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceComponentUptimeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	start, startOk := parseDate(d.Get("start").(string))
+	end, endOk := parseDate(d.Get("end").(string))
+	if startOk && endOk && start.After(end) {
+		return diag.Errorf("start (%s) must not be after end (%s)", d.Get("start"), d.Get("end"))
+	}
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	req := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentIdUptime(authV1, d.Get("page_id").(string), d.Get("component_id").(string))
+	if start, ok := d.GetOk("start"); ok {
+		req = req.Start(start.(string))
+	}
+	if end, ok := d.GetOk("end"); ok {
+		req = req.End(end.(string))
+	}
+
+	res, httpResp, err := req.Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component uptime using Status Page API")
+	}
+
+	d.SetId(GenerateDataSourceHashID("DataSourceComponentUptime-", dataSourceComponentUptime(), d))
+	d.Set("name", res.GetName())
+
+	return setUptime(d, &res)
+}
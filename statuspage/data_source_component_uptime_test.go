@@ -0,0 +1,89 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func TestAccStatuspageComponentUptimeDatasource(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceStatuspageComponentUptimeConfig(rid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.statuspage_component_uptime.default", "name", "statuspage_component.default", "name"),
+					resource.TestCheckResourceAttrSet("data.statuspage_component_uptime.default", "uptime_percentage"),
+					resource.TestCheckResourceAttrSet("data.statuspage_component_uptime.default", "range_end"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceStatuspageComponentUptimeConfig(uniq int) string {
+	return fmt.Sprintf(`
+	%s
+	data "statuspage_component_uptime" "default" {
+		page_id      = var.pageid
+		component_id = statuspage_component.default.id
+	}`, testAccStatuspageComponentsConfig(uniq))
+}
+
+func TestUnitSetUptime(t *testing.T) {
+
+	var event sp.ComponentUptimeRelatedEvents
+	event.SetId("incident")
+	event.SetName("Outage")
+
+	var u sp.ComponentUptime
+	u.SetRangeStart("2024-01-01")
+	u.SetRangeEnd("2024-01-31")
+	u.SetUptimePercentage(99.5)
+	u.SetMajorOutage(60)
+	u.SetRelatedEvents([]sp.ComponentUptimeRelatedEvents{event})
+
+	d := dataSourceComponentUptime().TestResourceData()
+	if diags := setUptime(d, &u); diags.HasError() {
+		t.Fatalf("setUptime() returned errors: %v", diags)
+	}
+
+	if got := d.Get("uptime_percentage").(float64); got != 99.5 {
+		t.Errorf("uptime_percentage = %v, want 99.5", got)
+	}
+	if got := d.Get("major_outage").(int); got != 60 {
+		t.Errorf("major_outage = %v, want 60", got)
+	}
+	if got := d.Get("related_events.0.id").(string); got != "incident" {
+		t.Errorf("related_events.0.id = %q, want incident", got)
+	}
+	if got := d.Get("range_start").(string); got != "2024-01-01" {
+		t.Errorf("range_start = %q, want 2024-01-01", got)
+	}
+}
+
+func TestUnitComponentUptimeRange(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, dataSourceComponentUptime().Schema, map[string]interface{}{
+		"page_id":      "page",
+		"component_id": "component",
+		"start":        "2024-02-01",
+		"end":          "2024-01-01",
+	})
+	diags := dataSourceComponentUptimeRead(context.Background(), d, nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "must not be after end") {
+		t.Errorf("dataSourceComponentUptimeRead() = %v, want a range error", diags)
+	}
+}
@@ -1,6 +1,9 @@
 package statuspage
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -8,16 +11,24 @@ import (
 func dataSourceComponents() *schema.Resource {
 	return &schema.Resource{
 		Description: "",
-		Read:        dataSourceComponentsRead,
+		ReadContext: dataSourceComponentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 			"page_id": {
-				Description:  "the ID of the page this component belongs to",
+				Description:  "the ID of the page this component belongs to. Defaults to the page_id of the provider",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"page_name": {
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"page_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
 			// Computed values
 			"components": {
 				Type:     schema.TypeList,
@@ -53,6 +64,34 @@ func dataSourceComponents() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"showcase": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"only_show_if_degraded": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"page_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -60,31 +99,24 @@ func dataSourceComponents() *schema.Resource {
 	}
 }
 
-func dataSourceComponentsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	providerConf := m.(*ProviderConfiguration)
-	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
-
-	res, _, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponents(authV1, d.Get("page_id").(string)).Page(1).PerPage(100).Execute()
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
-	if err != nil {
-		return TranslateClientErrorDiag(err, "error querying component list")
+	res, diags := listComponents(ctx, m, d.Get("page_id").(string))
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(GenerateDataSourceHashID("DataSourceComponents-", dataSourceComponents(), d))
 	resources := []map[string]interface{}{}
 
 	for _, r := range res {
-		component := map[string]interface{}{}
-
 		if _, ok := r.GetNameOk(); ok && !r.GetGroup() {
-			component["id"] = r.GetId()
-			component["name"] = r.GetName()
-			component["description"] = r.GetDescription()
-			component["position"] = r.GetPosition()
-			component["group_id"] = r.GetGroupId()
-			component["automation_email"] = r.GetAutomationEmail()
+			component := flattenComponent(r)
+			component["page_id"] = r.GetPageId()
 
 			resources = append(resources, component)
 		}
@@ -96,7 +128,7 @@ func dataSourceComponentsRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err := d.Set("components", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
 func TestAccStatuspageComponentsDatasource(t *testing.T) {
@@ -31,6 +32,9 @@ func TestAccStatuspageComponentsDatasource(t *testing.T) {
 func checkDatasourceStatuspageComponentsAttrs(accProvider *schema.Provider, rand int) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		resource.TestCheckResourceAttr("data.statuspage_components.default", "page_id", pageID),
+		resource.TestCheckResourceAttr("data.statuspage_components.default", "components.0.page_id", pageID),
+		resource.TestCheckResourceAttrSet("data.statuspage_components.default", "components.0.status"),
+		resource.TestCheckResourceAttrSet("data.statuspage_components.default", "components.0.created_at"),
 	)
 }
 
@@ -62,3 +66,26 @@ func testAccDatasourceStatuspageComponentsConfig(uniq int) string {
 	page_id = "${var.pageid}"
 	}`, testAccStatuspageComponentsConfig(uniq))
 }
+
+func TestUnitComponentsFilterStatus(t *testing.T) {
+
+	var operational, degraded sp.Component
+	operational.SetId("1")
+	operational.SetStatus("operational")
+	degraded.SetId("2")
+	degraded.SetStatus("degraded_performance")
+
+	items := []map[string]interface{}{flattenComponent(operational), flattenComponent(degraded)}
+	filters := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"name":   "status",
+			"values": []interface{}{"operational"},
+			"regex":  false,
+		},
+	})
+
+	got := ApplyFilters(filters, items, dataSourceComponents().Schema["components"].Elem.(*schema.Resource).Schema)
+	if len(got) != 1 || got[0]["id"] != "1" {
+		t.Errorf("ApplyFilters() = %v, want the operational component only", got)
+	}
+}
@@ -1,6 +1,9 @@
 package statuspage
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -8,7 +11,7 @@ import (
 func dataSourcePages() *schema.Resource {
 	return &schema.Resource{
 		Description: "",
-		Read:        dataSourcePagesRead,
+		ReadContext: dataSourcePagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
@@ -22,26 +25,16 @@ func dataSourcePages() *schema.Resource {
 	}
 }
 
-func dataSourcePagesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourcePagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
-	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
 	page_name := d.Get("page_name").(string)
 
-	res, _, err := statuspageClientV1.PagesApi.GetPages(authV1).Execute()
-
+	pageID, err := providerConf.PageIDByName(ctx, page_name)
 	if err != nil {
-		return TranslateClientErrorDiag(err, "error querying pages list")
+		return diag.FromErr(err)
 	}
 
-	for _, r := range res {
-		if _, ok := r.GetNameOk(); ok {
-			if r.GetName() == page_name {
-				d.SetId(r.GetId())
-				break
-			}
-		}
-	}
+	d.SetId(pageID)
 	return nil
 }
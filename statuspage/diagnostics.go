@@ -0,0 +1,152 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// apiErrorPayload is the JSON body returned by the Status Page API on errors.
+// "error" is either a single message or a list of messages, "errors" holds
+// validation errors keyed by field name.
+type apiErrorPayload struct {
+	Error   interface{}         `json:"error"`
+	Message string              `json:"message"`
+	Errors  map[string][]string `json:"errors"`
+}
+
+// TranslateClientErrorDiagnostics returns an error of the Status Page API
+// client as diagnostics. The JSON error payload is parsed so that each field
+// error points at the matching attribute, and the HTTP status and request ID
+// are added to the details.
+func TranslateClientErrorDiagnostics(err error, httpResp *http.Response, msg string) diag.Diagnostics {
+	if msg == "" {
+		msg = "an error occurred"
+	}
+
+	apiErr, ok := err.(sp.GenericOpenAPIError)
+	if !ok {
+		if errUrl, ok := err.(*url.Error); ok {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  msg,
+				Detail:   fmt.Sprintf("(url.Error): %s", errUrl),
+			}}
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  msg,
+			Detail:   appendResponseDetails(err.Error(), httpResp),
+		}}
+	}
+
+	return apiErrorDiagnostics(msg, apiErr.Error(), apiErr.Body(), httpResp)
+}
+
+// apiErrorDiagnostics parses the body of an API error into diagnostics.
+func apiErrorDiagnostics(msg string, errText string, body []byte, httpResp *http.Response) diag.Diagnostics {
+	var payload apiErrorPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		detail := errText
+		if b := strings.TrimSpace(string(body)); b != "" {
+			detail = fmt.Sprintf("%s: %s", detail, b)
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  msg,
+			Detail:   appendResponseDetails(detail, httpResp),
+		}}
+	}
+
+	var diags diag.Diagnostics
+
+	messages := payloadMessages(payload)
+	if len(messages) > 0 || len(payload.Errors) == 0 {
+		detail := strings.Join(messages, "\n")
+		if detail == "" {
+			detail = errText
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  msg,
+			Detail:   appendResponseDetails(detail, httpResp),
+		})
+	}
+
+	fields := make([]string, 0, len(payload.Errors))
+	for field := range payload.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s: invalid %s", msg, field),
+			Detail:        appendResponseDetails(fmt.Sprintf("%s %s", field, strings.Join(payload.Errors[field], ", ")), httpResp),
+			AttributePath: cty.GetAttrPath(field),
+		})
+	}
+
+	return diags
+}
+
+func payloadMessages(payload apiErrorPayload) []string {
+	var messages []string
+
+	switch e := payload.Error.(type) {
+	case string:
+		if e != "" {
+			messages = append(messages, e)
+		}
+	case []interface{}:
+		for _, m := range e {
+			messages = append(messages, fmt.Sprint(m))
+		}
+	}
+	if payload.Message != "" {
+		messages = append(messages, payload.Message)
+	}
+
+	return messages
+}
+
+func appendResponseDetails(detail string, httpResp *http.Response) string {
+	if httpResp == nil {
+		return detail
+	}
+
+	detail = fmt.Sprintf("%s\n\nHTTP status: %s", detail, httpResp.Status)
+	if requestID := httpResp.Header.Get("X-Request-Id"); requestID != "" {
+		detail = fmt.Sprintf("%s\nRequest ID: %s", detail, requestID)
+	}
+	return detail
+}
+
+// DiagnosticsError returns the errors of diagnostics as a single error, for
+// the functions of the SDK which cannot return diagnostics such as importers.
+func DiagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			messages = append(messages, d.Summary)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
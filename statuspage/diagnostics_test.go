@@ -0,0 +1,78 @@
+package statuspage
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestUnitApiErrorDiagnostics(t *testing.T) {
+
+	httpResp := &http.Response{
+		Status:     "422 Unprocessable Entity",
+		StatusCode: http.StatusUnprocessableEntity,
+		Header:     http.Header{"X-Request-Id": []string{"abc-123"}},
+	}
+
+	tests := []struct {
+		name          string
+		body          string
+		wantCount     int
+		wantDetail    string
+		wantAttribute string
+	}{
+		{name: "errorString", body: `{"error":"Component name can't be blank"}`, wantCount: 1, wantDetail: "Component name can't be blank"},
+		{name: "errorList", body: `{"error":["Name can't be blank","Status is invalid"]}`, wantCount: 1, wantDetail: "Name can't be blank\nStatus is invalid"},
+		{name: "message", body: `{"message":"Not found"}`, wantCount: 1, wantDetail: "Not found"},
+		{name: "fieldErrors", body: `{"errors":{"start_date":["is not a date"]}}`, wantCount: 1, wantDetail: "start_date is not a date", wantAttribute: "start_date"},
+		{name: "notJson", body: `<html>Bad Gateway</html>`, wantCount: 1, wantDetail: "422 Unprocessable Entity: <html>Bad Gateway</html>"},
+		{name: "emptyBody", body: ``, wantCount: 1, wantDetail: "422 Unprocessable Entity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := apiErrorDiagnostics("failed", "422 Unprocessable Entity", []byte(tt.body), httpResp)
+			if len(diags) != tt.wantCount {
+				t.Fatalf("apiErrorDiagnostics() returned %d diagnostics, want %d", len(diags), tt.wantCount)
+			}
+
+			d := diags[0]
+			if d.Severity != diag.Error {
+				t.Errorf("apiErrorDiagnostics() severity = %v, want error", d.Severity)
+			}
+			if !strings.HasPrefix(d.Detail, tt.wantDetail) {
+				t.Errorf("apiErrorDiagnostics() detail = %q, want prefix %q", d.Detail, tt.wantDetail)
+			}
+			if !strings.Contains(d.Detail, "HTTP status: 422 Unprocessable Entity") || !strings.Contains(d.Detail, "Request ID: abc-123") {
+				t.Errorf("apiErrorDiagnostics() detail = %q, want HTTP status and request ID", d.Detail)
+			}
+			if tt.wantAttribute != "" && !d.AttributePath.Equals(cty.GetAttrPath(tt.wantAttribute)) {
+				t.Errorf("apiErrorDiagnostics() attribute path = %#v, want %s", d.AttributePath, tt.wantAttribute)
+			}
+		})
+	}
+}
+
+func TestUnitTranslateClientErrorDiagnostics_GenericError(t *testing.T) {
+	diags := TranslateClientErrorDiagnostics(errors.New("boom"), nil, "")
+	if len(diags) != 1 || diags[0].Summary != "an error occurred" || diags[0].Detail != "boom" {
+		t.Errorf("TranslateClientErrorDiagnostics() = %#v", diags)
+	}
+}
+
+func TestUnitDiagnosticsError(t *testing.T) {
+	if err := DiagnosticsError(nil); err != nil {
+		t.Errorf("DiagnosticsError(nil) = %v, want nil", err)
+	}
+
+	diags := diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "warning"},
+		{Severity: diag.Error, Summary: "failed", Detail: "boom"},
+	}
+	if err := DiagnosticsError(diags); err == nil || err.Error() != "failed: boom" {
+		t.Errorf("DiagnosticsError() = %v, want failed: boom", err)
+	}
+}
@@ -3,7 +3,10 @@ package statuspage
 import (
 	"bytes"
 	"fmt"
+	"log"
+	"net/http"
 	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sbecker59/terraform-provider-statuspage/statuspage/internal/hashcode"
@@ -52,3 +55,95 @@ func StringListFromSchemaKey(d *schema.ResourceData, key string) []string {
 	}
 	return v
 }
+
+// HandleNotFoundError clears the ID of a resource when the API answered with a
+// 404, so that Terraform plans to recreate a resource deleted outside of it.
+// It returns true when the resource is gone.
+func HandleNotFoundError(d *schema.ResourceData, httpResp *http.Response, resourceType string) bool {
+	if httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		return false
+	}
+
+	log.Printf("[WARN] Statuspage could not find %s with ID %s, removing it from state", resourceType, d.Id())
+	d.SetId("")
+	return true
+}
+
+// dateFormat is the YYYY-MM-DD format of the dates of the Status Page API.
+const dateFormat = "2006-01-02"
+
+// validateDate checks that an attribute is a date in YYYY-MM-DD format.
+func validateDate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := time.Parse(dateFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a date in YYYY-MM-DD format, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// validateDuration checks that an attribute is a positive duration accepted by
+// time.ParseDuration, e.g. "500ms" or "30s".
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration such as \"500ms\" or \"30s\", got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// parseDate parses a date in YYYY-MM-DD format, or a timestamp in RFC 3339
+// format of which only the date is kept.
+func parseDate(s string) (time.Time, bool) {
+	if t, err := time.Parse(dateFormat, s); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), true
+	}
+	return time.Time{}, false
+}
+
+// normalizeDate returns a date of the API in YYYY-MM-DD format, or unchanged
+// when it cannot be parsed.
+func normalizeDate(s string) string {
+	if t, ok := parseDate(s); ok {
+		return t.Format(dateFormat)
+	}
+	return s
+}
+
+// suppressEquivalentDate suppresses the diff between two encodings of the same
+// date, e.g. 2024-01-31 and 2024-01-31T00:00:00Z.
+func suppressEquivalentDate(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, ok := parseDate(old)
+	if !ok {
+		return false
+	}
+	newDate, ok := parseDate(new)
+	if !ok {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
+// suppressEquivalentTime suppresses the diff between two RFC 3339 encodings
+// of the same instant, e.g. 2024-01-31T10:00:00+01:00 and 2024-01-31T09:00:00Z.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
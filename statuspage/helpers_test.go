@@ -1,6 +1,7 @@
 package statuspage
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,3 +82,123 @@ func TestGenerateDataSourceHashID(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleNotFoundError(t *testing.T) {
+
+	resourceSchema := map[string]*schema.Schema{
+		"key1": {Type: schema.TypeString, Optional: true},
+	}
+
+	tests := []struct {
+		name     string
+		httpResp *http.Response
+		want     bool
+		wantId   string
+	}{
+		{name: "nilResponse", httpResp: nil, want: false, wantId: "id"},
+		{name: "notFound", httpResp: &http.Response{StatusCode: http.StatusNotFound}, want: true, wantId: ""},
+		{name: "unauthorized", httpResp: &http.Response{StatusCode: http.StatusUnauthorized}, want: false, wantId: "id"},
+		{name: "serverError", httpResp: &http.Response{StatusCode: http.StatusInternalServerError}, want: false, wantId: "id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+			d.SetId("id")
+
+			if got := HandleNotFoundError(d, tt.httpResp, "component"); got != tt.want {
+				t.Errorf("HandleNotFoundError() = %v, want %v", got, tt.want)
+			}
+			if d.Id() != tt.wantId {
+				t.Errorf("HandleNotFoundError() left ID %q, want %q", d.Id(), tt.wantId)
+			}
+		})
+	}
+}
+
+func TestUnitValidateDate(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "valid", value: "2024-01-31"},
+		{name: "invalidDay", value: "2024-02-30", wantErr: true},
+		{name: "dateTime", value: "2024-01-31T00:00:00Z", wantErr: true},
+		{name: "otherFormat", value: "31/01/2024", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+		{name: "notString", value: 20240131, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateDate(tt.value, "start_date")
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateDate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitSuppressEquivalentDate(t *testing.T) {
+
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{name: "same", old: "2024-01-31", new: "2024-01-31", want: true},
+		{name: "timestamp", old: "2024-01-31T00:00:00Z", new: "2024-01-31", want: true},
+		{name: "otherDay", old: "2024-01-30", new: "2024-01-31", want: false},
+		{name: "unset", old: "", new: "2024-01-31", want: false},
+		{name: "invalid", old: "2024-01-31", new: "tomorrow", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suppressEquivalentDate("start_date", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("suppressEquivalentDate(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitNormalizeDate(t *testing.T) {
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "2024-01-31", want: "2024-01-31"},
+		{value: "2024-01-31T00:00:00Z", want: "2024-01-31"},
+		{value: "", want: ""},
+		{value: "someday", want: "someday"},
+	}
+	for _, tt := range tests {
+		if got := normalizeDate(tt.value); got != tt.want {
+			t.Errorf("normalizeDate(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestUnitSuppressEquivalentTime(t *testing.T) {
+
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{name: "same", old: "2024-01-31T09:00:00Z", new: "2024-01-31T09:00:00Z", want: true},
+		{name: "offset", old: "2024-01-31T09:00:00Z", new: "2024-01-31T10:00:00+01:00", want: true},
+		{name: "otherTime", old: "2024-01-31T09:00:00Z", new: "2024-01-31T10:00:00Z", want: false},
+		{name: "unset", old: "", new: "2024-01-31T09:00:00Z", want: false},
+		{name: "date", old: "2024-01-31T00:00:00Z", new: "2024-01-31", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suppressEquivalentTime("scheduled_for", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("suppressEquivalentTime(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
@@ -30,6 +30,15 @@ func TestStatuspageComponentGroup_import(t *testing.T) {
 					return fmt.Sprintf("%s/%s", pageID, rs.Primary.ID), nil
 				},
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(ts *terraform.State) (string, error) {
+					rs := ts.RootModule().Resources["statuspage_component_group.default"]
+					return fmt.Sprintf("%s/name:%s", pageID, rs.Primary.Attributes["name"]), nil
+				},
+			},
 		},
 	})
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"os"
@@ -47,31 +48,90 @@ var (
 	notTrustedErrorRe = regexp.MustCompile(`certificate is not trusted`)
 )
 
+// Options holds the retry settings used by NewClientWithOptions.
+type Options struct {
+	// RetryWaitMin is the minimum time to wait between two attempts.
+	RetryWaitMin time.Duration
+	// RetryWaitMax is the maximum time to wait between two attempts.
+	RetryWaitMax time.Duration
+	// RetryMax is the maximum number of retries, 0 disables retries.
+	RetryMax int
+	// RetryOnStatus lists additional HTTP status codes to retry on.
+	RetryOnStatus []int
+}
+
+// DefaultOptions returns the retry settings used by NewClient.
+func DefaultOptions() Options {
+	return Options{
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+		RetryMax:     defaultRetryMax,
+	}
+}
+
 func NewClient() *retryablehttp.Client {
+	return NewClientWithOptions(DefaultOptions())
+}
+
+// NewClientWithOptions creates a client with the given retry settings. Zero
+// wait durations fall back to the package defaults.
+func NewClientWithOptions(opts Options) *retryablehttp.Client {
+	if opts.RetryWaitMin <= 0 {
+		opts.RetryWaitMin = defaultRetryWaitMin
+	}
+	if opts.RetryWaitMax <= 0 {
+		opts.RetryWaitMax = defaultRetryWaitMax
+	}
+
+	checkRetry := DefaultRetryPolicy
+	if len(opts.RetryOnStatus) > 0 {
+		checkRetry = RetryOnStatusPolicy(opts.RetryOnStatus)
+	}
+
 	client := &retryablehttp.Client{
 		HTTPClient:   cleanhttp.DefaultPooledClient(),
 		Logger:       defaultLogger,
-		RetryWaitMin: defaultRetryWaitMin,
-		RetryWaitMax: defaultRetryWaitMax,
-		RetryMax:     defaultRetryMax,
-		CheckRetry:   DefaultRetryPolicy,
-		Backoff:      DefaultBackoff,
+		RetryWaitMin: opts.RetryWaitMin,
+		RetryWaitMax: opts.RetryWaitMax,
+		RetryMax:     opts.RetryMax,
+		CheckRetry:   checkRetry,
+		Backoff:      JitterBackoff,
 	}
 
 	return client
 }
 
+// DefaultBackoff provides a default callback for Client.Backoff. It honours
+// the Retry-After header of rate limited responses, in both the delay-seconds
+// and the HTTP-date forms, and otherwise waits exponentially longer on each
+// attempt. The result never exceeds max.
 func DefaultBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-	if resp != nil {
-		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 420 || resp.StatusCode == http.StatusServiceUnavailable {
-			if s, ok := resp.Header["Retry-After"]; ok {
-				if sleep, err := strconv.ParseInt(s[0], 10, 64); err == nil {
-					return time.Second * time.Duration(sleep)
-				}
-			}
+	if sleep, ok := retryAfter(resp); ok {
+		if sleep > max {
+			sleep = max
 		}
+		return sleep
+	}
+
+	return exponentialBackoff(min, max, attemptNum)
+}
+
+// JitterBackoff behaves like DefaultBackoff but picks a random wait between
+// min and the exponential backoff, so that parallel requests failing at the
+// same time do not retry in lockstep. Retry-After headers are honoured as is.
+func JitterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if _, ok := retryAfter(resp); ok {
+		return DefaultBackoff(min, max, attemptNum, resp)
 	}
 
+	sleep := exponentialBackoff(min, max, attemptNum)
+	if sleep <= min {
+		return sleep
+	}
+	return min + rand.N(sleep-min+1)
+}
+
+func exponentialBackoff(min, max time.Duration, attemptNum int) time.Duration {
 	mult := math.Pow(2, float64(attemptNum)) * float64(min)
 	sleep := time.Duration(mult)
 	if float64(sleep) != mult || sleep > max {
@@ -80,6 +140,37 @@ func DefaultBackoff(min, max time.Duration, attemptNum int, resp *http.Response)
 	return sleep
 }
 
+// retryAfter returns the wait requested by the Retry-After header of a rate
+// limited or unavailable response. Dates in the past are ignored.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != 420 && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	s := resp.Header.Get("Retry-After")
+	if s == "" {
+		return 0, false
+	}
+
+	if sleep, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if sleep < 0 {
+			return 0, false
+		}
+		return time.Second * time.Duration(sleep), true
+	}
+
+	if date, err := http.ParseTime(s); err == nil {
+		if sleep := time.Until(date); sleep > 0 {
+			return sleep, true
+		}
+	}
+
+	return 0, false
+}
+
 // DefaultRetryPolicy provides a default callback for Client.CheckRetry, which
 // will retry on connection errors and server errors.
 func DefaultRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
@@ -105,6 +196,27 @@ func ErrorPropagatedRetryPolicy(ctx context.Context, resp *http.Response, err er
 	return baseRetryPolicy(resp, err)
 }
 
+// RetryOnStatusPolicy returns a callback for Client.CheckRetry which behaves
+// like DefaultRetryPolicy and also retries on the given HTTP status codes.
+func RetryOnStatusPolicy(statusCodes []int) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		// do not retry on context.Canceled or context.DeadlineExceeded
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		if err == nil && resp != nil {
+			for _, statusCode := range statusCodes {
+				if resp.StatusCode == statusCode {
+					return true, nil
+				}
+			}
+		}
+
+		return DefaultRetryPolicy(ctx, resp, err)
+	}
+}
+
 func isCertError(err error) bool {
 	_, ok := err.(*tls.CertificateVerificationError)
 	return ok
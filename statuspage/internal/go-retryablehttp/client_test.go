@@ -288,3 +288,128 @@ func TestClient_BackoffCustom(t *testing.T) {
 		t.Fatalf("expected retries: %d != %d", client.RetryMax, retries)
 	}
 }
+
+func TestClient_NewClientWithOptions(t *testing.T) {
+	client := NewClientWithOptions(Options{
+		RetryWaitMin: 2 * time.Second,
+		RetryWaitMax: 10 * time.Second,
+		RetryMax:     7,
+	})
+
+	if client.RetryWaitMin != 2*time.Second {
+		t.Fatalf("expected RetryWaitMin 2s, got %s", client.RetryWaitMin)
+	}
+	if client.RetryWaitMax != 10*time.Second {
+		t.Fatalf("expected RetryWaitMax 10s, got %s", client.RetryWaitMax)
+	}
+	if client.RetryMax != 7 {
+		t.Fatalf("expected RetryMax 7, got %d", client.RetryMax)
+	}
+
+	client = NewClientWithOptions(Options{})
+	if client.RetryWaitMin != defaultRetryWaitMin || client.RetryWaitMax != defaultRetryWaitMax {
+		t.Fatalf("expected default wait durations, got %s and %s", client.RetryWaitMin, client.RetryWaitMax)
+	}
+	if client.RetryMax != 0 {
+		t.Fatalf("expected RetryMax 0, got %d", client.RetryMax)
+	}
+}
+
+func TestClient_RetryOnStatusPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   int
+		expect bool
+	}{
+		{"http_409_listed", http.StatusConflict, true},
+		{"http_404_not_listed", http.StatusNotFound, false},
+		{"http_429_default", http.StatusTooManyRequests, true},
+		{"http_500_default", http.StatusInternalServerError, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.code)
+			}))
+			defer ts.Close()
+
+			client := NewClient()
+			policy := RetryOnStatusPolicy([]int{http.StatusConflict})
+
+			retryable := false
+			client.CheckRetry = func(_ context.Context, resp *http.Response, err error) (bool, error) {
+				retryable, _ = policy(context.Background(), resp, err)
+				return false, nil
+			}
+
+			if _, err := client.Get(ts.URL); err != nil {
+				t.Fatalf("err: %v", err)
+			}
+
+			if retryable != test.expect {
+				t.Fatalf("expected retryable to be %t for HTTP %d", test.expect, test.code)
+			}
+		})
+	}
+}
+
+func TestBackoff_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        int
+		retryHeader string
+		attempt     int
+		expectMin   time.Duration
+		expectMax   time.Duration
+	}{
+		{"seconds", http.StatusTooManyRequests, "5", 0, 5 * time.Second, 5 * time.Second},
+		{"seconds_capped", http.StatusTooManyRequests, "120", 0, 30 * time.Second, 30 * time.Second},
+		{"date_future", 420, time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), 0, 8 * time.Second, 10 * time.Second},
+		{"date_future_capped", http.StatusServiceUnavailable, time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), 0, 30 * time.Second, 30 * time.Second},
+		{"date_past", http.StatusTooManyRequests, "Fri, 31 Dec 1999 23:59:59 GMT", 2, 4 * time.Second, 4 * time.Second},
+		{"invalid", http.StatusTooManyRequests, "tomorrow", 2, 4 * time.Second, 4 * time.Second},
+		{"negative", http.StatusTooManyRequests, "-5", 2, 4 * time.Second, 4 * time.Second},
+		{"ignored_status", http.StatusInternalServerError, "5", 2, 4 * time.Second, 4 * time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: test.code,
+				Header:     http.Header{"Retry-After": []string{test.retryHeader}},
+			}
+
+			v := DefaultBackoff(time.Second, 30*time.Second, test.attempt, resp)
+			if v < test.expectMin || v > test.expectMax {
+				t.Fatalf("expected backoff between %s and %s, got %s", test.expectMin, test.expectMax, v)
+			}
+		})
+	}
+}
+
+func TestJitterBackoff(t *testing.T) {
+	cases := []struct {
+		i         int
+		expectMax time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{63, 5 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		for n := 0; n < 100; n++ {
+			if v := JitterBackoff(time.Second, 5*time.Minute, tc.i, nil); v < time.Second || v > tc.expectMax {
+				t.Fatalf("bad: %#v -> %s", tc, v)
+			}
+		}
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+	if v := JitterBackoff(time.Second, 5*time.Minute, 5, resp); v != 3*time.Second {
+		t.Fatalf("expected Retry-After to be honoured, got %s", v)
+	}
+}
@@ -0,0 +1,202 @@
+package httplog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	redacted = "***"
+
+	// maxBodySize is the number of body bytes written to the logs.
+	maxBodySize = 16 * 1024
+)
+
+var (
+	// sensitiveHeaders are masked in the logged requests and responses.
+	sensitiveHeaders = map[string]bool{
+		"Authorization": true,
+		"Cookie":        true,
+		"Set-Cookie":    true,
+	}
+
+	// sensitiveFields are masked in the logged JSON bodies, at any depth.
+	sensitiveFields = map[string]bool{
+		"api_key":         true,
+		"api_token":       true,
+		"application_key": true,
+		"password":        true,
+	}
+)
+
+// Transport is an http.RoundTripper logging the requests sent to the API and
+// the responses received, with credentials redacted.
+type Transport struct {
+	Base  http.RoundTripper
+	Level string
+}
+
+// NewTransport wraps base so that every exchange is logged at the given level,
+// e.g. "DEBUG".
+func NewTransport(base http.RoundTripper, level string) *Transport {
+	return &Transport{
+		Base:  base,
+		Level: level,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[%s] Status Page API request: %s %s\n%s%s",
+		t.Level, req.Method, req.URL, formatHeaders(req.Header), formatBody(reqBody))
+
+	start := time.Now()
+	resp, err := t.base().RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		log.Printf("[%s] Status Page API request failed: %s %s (%s): %s",
+			t.Level, req.Method, req.URL, latency, err)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+
+	// The body is closed whether or not it could be read.
+	respBody, err := readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[%s] Status Page API response: %s %s -> %s (%s)\n%s%s",
+		t.Level, req.Method, req.URL, resp.Status, latency, formatHeaders(resp.Header), formatBody(respBody))
+
+	return resp, nil
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// readRequestBody returns the request body and rewinds it for the next
+// transport.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// readResponseBody returns the response body and rewinds it for the caller.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func formatHeaders(header http.Header) string {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf strings.Builder
+	for _, key := range keys {
+		value := strings.Join(header.Values(key), ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			value = RedactHeader(value)
+		}
+		fmt.Fprintf(&buf, "%s: %s\n", key, value)
+	}
+	return buf.String()
+}
+
+func formatBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	body = RedactBody(body)
+	if len(body) > maxBodySize {
+		return fmt.Sprintf("\n%s... (%d bytes truncated)", body[:maxBodySize], len(body)-maxBodySize)
+	}
+	return "\n" + string(body)
+}
+
+// RedactHeader masks a header value, keeping the authentication scheme
+// (e.g. "OAuth") when there is one.
+func RedactHeader(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + redacted
+	}
+	return redacted
+}
+
+// RedactBody masks the sensitive fields of a JSON body. Bodies which are not
+// valid JSON are returned unchanged.
+func RedactBody(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if sensitiveFields[key] {
+				value[key] = redacted
+				continue
+			}
+			value[key] = redactValue(field)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
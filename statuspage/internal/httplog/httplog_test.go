@@ -0,0 +1,137 @@
+package httplog
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeader(t *testing.T) {
+	tests := []struct {
+		value  string
+		expect string
+	}{
+		{"OAuth 1234-abcd", "OAuth ***"},
+		{"1234-abcd", "***"},
+	}
+	for _, test := range tests {
+		if v := RedactHeader(test.value); v != test.expect {
+			t.Fatalf("RedactHeader(%q) = %q, expected %q", test.value, v, test.expect)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		expect string
+	}{
+		{"metrics_provider", `{"metrics_provider":{"api_key":"secret","password":"secret","type":"Datadog"}}`, `{"metrics_provider":{"api_key":"***","password":"***","type":"Datadog"}}`},
+		{"list", `[{"api_token":"secret","id":"1"}]`, `[{"api_token":"***","id":"1"}]`},
+		{"no_sensitive_field", `{"component":{"name":"API"}}`, `{"component":{"name":"API"}}`},
+		{"not_json", `api_key=secret`, `api_key=secret`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if v := string(RedactBody([]byte(test.body))); v != test.expect {
+				t.Fatalf("expected %s, got %s", test.expect, v)
+			}
+		})
+	}
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"api_key":"secret"`) {
+			t.Errorf("expected the request body to be sent unchanged, got %s", body)
+		}
+		w.WriteHeader(201)
+		w.Write([]byte(`{"id":"1","type":"Datadog"}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: NewTransport(nil, "DEBUG")}
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/pages/1/metrics_providers", strings.NewReader(`{"metrics_provider":{"api_key":"secret"}}`))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	req.Header.Set("Authorization", "OAuth 1234-abcd")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"id":"1","type":"Datadog"}` {
+		t.Fatalf("expected the response body to be readable, got %s", body)
+	}
+
+	logs := buf.String()
+	for _, expect := range []string{"[DEBUG] Status Page API request: POST", "Authorization: OAuth ***", `"api_key":"***"`, "-> 201 Created"} {
+		if !strings.Contains(logs, expect) {
+			t.Errorf("expected logs to contain %q, got:\n%s", expect, logs)
+		}
+	}
+	for _, secret := range []string{"1234-abcd", `"secret"`} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected logs not to contain %q, got:\n%s", secret, logs)
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type failingBody struct {
+	closed bool
+}
+
+func (b *failingBody) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (b *failingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestTransport_RoundTripBodyError(t *testing.T) {
+	body := &failingBody{}
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Status: "200 OK", Header: http.Header{}, Body: body}, nil
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.statuspage.io/v1/pages", nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	resp, err := NewTransport(base, "DEBUG").RoundTrip(req)
+	if err == nil {
+		t.Fatalf("expected an error reading the response body")
+	}
+	if resp != nil {
+		t.Errorf("expected no response with the error, got %v", resp)
+	}
+	if !body.closed {
+		t.Errorf("expected the response body to be closed")
+	}
+}
@@ -0,0 +1,142 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+const importNamePrefix = "name:"
+
+// parseImportID splits an import ID of the form 'page-id/id' or
+// 'page-id/name:<name>'. The name may contain slashes.
+func parseImportID(id string, format string) (pageID string, ref string, byName bool, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use '%s' or 'page-id/name:<name>'", id, format)
+	}
+
+	if strings.HasPrefix(parts[1], importNamePrefix) {
+		name := strings.TrimPrefix(parts[1], importNamePrefix)
+		if name == "" {
+			return "", "", false, fmt.Errorf("[ERROR] Invalid resource format: %s. The name is empty", id)
+		}
+		return parts[0], name, true, nil
+	}
+	if strings.Contains(parts[1], "/") {
+		return "", "", false, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use '%s' or 'page-id/name:<name>'", id, format)
+	}
+	return parts[0], parts[1], false, nil
+}
+
+// listComponents returns all the components of a page, including the
+// components representing groups.
+func listComponents(ctx context.Context, m interface{}, pageID string) ([]sp.Component, diag.Diagnostics) {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	components, _, diags := paginate(ctx, "components", func(page int32, perPage int32) ([]sp.Component, *http.Response, error) {
+		return statuspageClientV1.ComponentsApi.GetPagesPageIdComponents(authV1, pageID).Page(page).PerPage(perPage).Execute()
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return components, nil
+}
+
+// listComponentGroups returns all the component groups of a page.
+func listComponentGroups(ctx context.Context, m interface{}, pageID string) ([]sp.GroupComponent, diag.Diagnostics) {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	groups, _, diags := paginate(ctx, "component groups", func(page int32, perPage int32) ([]sp.GroupComponent, *http.Response, error) {
+		return statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroups(authV1, pageID).Page(page).PerPage(perPage).Execute()
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return groups, nil
+}
+
+// findComponentIDByName returns the ID of the component of a page with the
+// given name, and fails when no component or several components have that
+// name.
+func findComponentIDByName(ctx context.Context, m interface{}, pageID string, name string) (string, diag.Diagnostics) {
+	component, diags := findComponentByName(ctx, m, pageID, name)
+	if diags.HasError() {
+		return "", diags
+	}
+	return component.GetId(), nil
+}
+
+// findComponentByName returns the component of a page with the given name, and
+// fails when no component or several components have that name.
+func findComponentByName(ctx context.Context, m interface{}, pageID string, name string) (*sp.Component, diag.Diagnostics) {
+	components, diags := listComponents(ctx, m, pageID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var matches []sp.Component
+	var ids []string
+	for _, c := range components {
+		if !c.GetGroup() && c.GetName() == name {
+			matches = append(matches, c)
+			ids = append(ids, c.GetId())
+		}
+	}
+	if _, diags := uniqueID(ids, "component", name, pageID); diags.HasError() {
+		return nil, diags
+	}
+	return &matches[0], nil
+}
+
+// findComponentGroupIDByName returns the ID of the component group of a page
+// with the given name, and fails when no group or several groups have that
+// name.
+func findComponentGroupIDByName(ctx context.Context, m interface{}, pageID string, name string) (string, diag.Diagnostics) {
+	group, diags := findComponentGroupByName(ctx, m, pageID, name)
+	if diags.HasError() {
+		return "", diags
+	}
+	return group.GetId(), nil
+}
+
+// findComponentGroupByName returns the component group of a page with the
+// given name, and fails when no group or several groups have that name.
+func findComponentGroupByName(ctx context.Context, m interface{}, pageID string, name string) (*sp.GroupComponent, diag.Diagnostics) {
+	groups, diags := listComponentGroups(ctx, m, pageID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var matches []sp.GroupComponent
+	var ids []string
+	for _, g := range groups {
+		if g.GetName() == name {
+			matches = append(matches, g)
+			ids = append(ids, g.GetId())
+		}
+	}
+	if _, diags := uniqueID(ids, "component group", name, pageID); diags.HasError() {
+		return nil, diags
+	}
+	return &matches[0], nil
+}
+
+func uniqueID(ids []string, kind string, name string, pageID string) (string, diag.Diagnostics) {
+	switch len(ids) {
+	case 0:
+		return "", diag.Errorf("no %s found with name %q in page %s", kind, name, pageID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", diag.Errorf("%d %ss found with name %q in page %s, use the ID instead: %s", len(ids), kind, name, pageID, strings.Join(ids, ", "))
+	}
+}
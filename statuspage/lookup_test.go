@@ -0,0 +1,61 @@
+package statuspage
+
+import (
+	"testing"
+)
+
+func TestUnitParseImportID(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		id         string
+		wantPageID string
+		wantRef    string
+		wantByName bool
+		wantErr    bool
+	}{
+		{name: "id", id: "page/component", wantPageID: "page", wantRef: "component"},
+		{name: "name", id: "page/name:My API", wantPageID: "page", wantRef: "My API", wantByName: true},
+		{name: "nameWithSlash", id: "page/name:API / EU", wantPageID: "page", wantRef: "API / EU", wantByName: true},
+		{name: "emptyName", id: "page/name:", wantErr: true},
+		{name: "missingID", id: "page", wantErr: true},
+		{name: "emptyPageID", id: "/component", wantErr: true},
+		{name: "tooManyParts", id: "page/component/other", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageID, ref, byName, err := parseImportID(tt.id, "page-id/component-id")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if pageID != tt.wantPageID || ref != tt.wantRef || byName != tt.wantByName {
+				t.Errorf("parseImportID() = (%q, %q, %v), want (%q, %q, %v)", pageID, ref, byName, tt.wantPageID, tt.wantRef, tt.wantByName)
+			}
+		})
+	}
+}
+
+func TestUnitUniqueID(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		ids     []string
+		want    string
+		wantErr bool
+	}{
+		{name: "none", ids: nil, wantErr: true},
+		{name: "one", ids: []string{"a"}, want: "a"},
+		{name: "ambiguous", ids: []string{"a", "b"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := uniqueID(tt.ids, "component", "API", "page")
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("uniqueID() diags = %v, wantErr %v", diags, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("uniqueID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
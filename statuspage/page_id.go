@@ -0,0 +1,154 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+var errPageIDNotSet = errors.New("page_id or page_name must be set on the resource or in the provider configuration")
+
+// pageIndex caches the pages of the account, which are listed at most once
+// per provider instance to resolve page names.
+type pageIndex struct {
+	mu    sync.Mutex
+	pages []sp.Page
+}
+
+// PageIDByName returns the ID of the page with the given name, and fails when
+// no page or several pages have that name.
+func (p *ProviderConfiguration) PageIDByName(ctx context.Context, name string) (string, error) {
+	pages, err := p.listPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return findPageID(pages, name)
+}
+
+// DefaultPageID returns the page used by resources and data sources which set
+// neither page_id nor page_name.
+func (p *ProviderConfiguration) DefaultPageID(ctx context.Context) (string, error) {
+	if p.PageName != "" {
+		return p.PageIDByName(ctx, p.PageName)
+	}
+	if p.PageID == "" {
+		return "", errPageIDNotSet
+	}
+	return p.PageID, nil
+}
+
+func (p *ProviderConfiguration) listPages(ctx context.Context) ([]sp.Page, error) {
+	p.pages.mu.Lock()
+	defer p.pages.mu.Unlock()
+
+	if p.pages.pages != nil {
+		return p.pages.pages, nil
+	}
+
+	pages, httpResp, err := p.StatuspageClientV1.PagesApi.GetPages(p.AuthContext(ctx)).Execute()
+	if err != nil {
+		return nil, DiagnosticsError(TranslateClientErrorDiagnostics(err, httpResp, "failed to list pages using Status Page API"))
+	}
+
+	p.pages.pages = pages
+	return pages, nil
+}
+
+func findPageID(pages []sp.Page, name string) (string, error) {
+	var ids []string
+	for _, page := range pages {
+		if page.GetName() == name {
+			ids = append(ids, page.GetId())
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no page found with name %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d pages found with name %q, use page_id instead: %s", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
+// resolvePageID sets page_id from page_name or from the provider default when
+// it is not set on the resource or data source, and records it in state.
+func resolvePageID(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	if pageID, ok := d.GetOk("page_id"); ok && pageID.(string) != "" {
+		return nil
+	}
+
+	providerConf, ok := m.(*ProviderConfiguration)
+	if !ok {
+		return errPageIDNotSet
+	}
+
+	var pageID string
+	var err error
+	if pageName, ok := d.GetOk("page_name"); ok {
+		pageID, err = providerConf.PageIDByName(ctx, pageName.(string))
+	} else {
+		pageID, err = providerConf.DefaultPageID(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	return d.Set("page_id", pageID)
+}
+
+// customizeDiffPageID plans the page_id resolved from page_name or from the
+// provider default for resources which do not set it, so that a change of
+// page replaces them.
+func customizeDiffPageID(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if pageID := rawConfig.GetAttr("page_id"); !pageID.IsNull() {
+		return nil
+	}
+
+	providerConf, ok := m.(*ProviderConfiguration)
+	if !ok {
+		return nil
+	}
+
+	var pageID string
+	var err error
+	if pageName := rawConfig.GetAttr("page_name"); !pageName.IsNull() {
+		if !pageName.IsKnown() {
+			return d.SetNewComputed("page_id")
+		}
+		pageID, err = providerConf.PageIDByName(ctx, pageName.AsString())
+	} else {
+		pageID, err = providerConf.DefaultPageID(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if d.Get("page_id").(string) == pageID {
+		// page_name resolves to the page already in state, e.g. after an
+		// import, which does not require a new resource.
+		if d.Id() != "" && d.HasChange("page_name") {
+			return d.Clear("page_name")
+		}
+		return nil
+	}
+
+	if err := d.SetNew("page_id", pageID); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("page_id")
+}
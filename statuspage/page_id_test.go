@@ -0,0 +1,76 @@
+package statuspage
+
+import (
+	"context"
+	"testing"
+
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func TestUnitResolvePageID(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		meta       interface{}
+		wantPageID string
+		wantErr    bool
+	}{
+		{name: "resourcePageID", config: map[string]interface{}{"page_id": "resource"}, meta: &ProviderConfiguration{PageID: "provider"}, wantPageID: "resource"},
+		{name: "providerPageID", config: map[string]interface{}{}, meta: &ProviderConfiguration{PageID: "provider"}, wantPageID: "provider"},
+		{name: "noPageID", config: map[string]interface{}{}, meta: &ProviderConfiguration{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceComponent().TestResourceData()
+			for k, v := range tt.config {
+				d.Set(k, v)
+			}
+
+			err := resolvePageID(context.Background(), d, tt.meta)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolvePageID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := d.Get("page_id").(string); got != tt.wantPageID {
+				t.Errorf("resolvePageID() page_id = %q, want %q", got, tt.wantPageID)
+			}
+		})
+	}
+}
+
+func TestUnitFindPageID(t *testing.T) {
+
+	newPage := func(id, name string) sp.Page {
+		var page sp.Page
+		page.SetId(id)
+		page.SetName(name)
+		return page
+	}
+	pages := []sp.Page{
+		newPage("1", "Public"),
+		newPage("2", "Internal"),
+		newPage("3", "Internal"),
+	}
+
+	tests := []struct {
+		name       string
+		pageName   string
+		wantPageID string
+		wantErr    bool
+	}{
+		{name: "match", pageName: "Public", wantPageID: "1"},
+		{name: "noMatch", pageName: "Missing", wantErr: true},
+		{name: "multipleMatches", pageName: "Internal", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findPageID(pages, tt.pageName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findPageID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantPageID {
+				t.Errorf("findPageID() = %q, want %q", got, tt.wantPageID)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// listPerPage is the number of items requested per page of a list call.
+	listPerPage = 100
+	// listMaxPages caps the number of pages of a list call, in case the API
+	// keeps returning full pages.
+	listMaxPages = 100
+)
+
+// listPageFunc fetches one page of a list endpoint of the Status Page API,
+// e.g. by calling Page(page).PerPage(perPage).Execute() on the request.
+type listPageFunc[T any] func(page int32, perPage int32) ([]T, *http.Response, error)
+
+// paginate calls list with increasing page numbers until it returns a page
+// shorter than listPerPage, and returns the items of all the pages. kind names
+// the listed items in the errors, e.g. "components". The HTTP response of a
+// failed call is returned for the error handling of the caller.
+func paginate[T any](ctx context.Context, kind string, list listPageFunc[T]) ([]T, *http.Response, diag.Diagnostics) {
+	return paginateN(ctx, kind, list, listPerPage, listMaxPages)
+}
+
+func paginateN[T any](ctx context.Context, kind string, list listPageFunc[T], perPage int32, maxPages int32) ([]T, *http.Response, diag.Diagnostics) {
+	var items []T
+
+	for page := int32(1); page <= maxPages; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, diag.FromErr(err)
+		}
+
+		res, httpResp, err := list(page, perPage)
+		if err != nil {
+			return nil, httpResp, TranslateClientErrorDiagnostics(err, httpResp, fmt.Sprintf("failed to list %s using Status Page API", kind))
+		}
+
+		items = append(items, res...)
+		if int32(len(res)) < perPage {
+			return items, httpResp, nil
+		}
+	}
+
+	return nil, nil, diag.Errorf("failed to list %s: more than %d pages of %d results, stopping at the limit", kind, maxPages, perPage)
+}
@@ -0,0 +1,88 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUnitPaginate(t *testing.T) {
+
+	// fakeList serves total items, perPage at a time.
+	fakeList := func(total int, calls *int) listPageFunc[int] {
+		return func(page int32, perPage int32) ([]int, *http.Response, error) {
+			*calls++
+			var items []int
+			for i := int(page-1) * int(perPage); i < total && len(items) < int(perPage); i++ {
+				items = append(items, i)
+			}
+			return items, &http.Response{StatusCode: 200}, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		total     int
+		wantItems int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "empty", total: 0, wantItems: 0, wantCalls: 1},
+		{name: "shortPage", total: 3, wantItems: 3, wantCalls: 1},
+		{name: "severalPages", total: 25, wantItems: 25, wantCalls: 3},
+		{name: "exactMultiple", total: 20, wantItems: 20, wantCalls: 3},
+		{name: "tooManyPages", total: 1000, wantCalls: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			items, httpResp, diags := paginateN(context.Background(), "items", fakeList(tt.total, &calls), 10, 5)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("paginateN() diags = %v, wantErr %v", diags, tt.wantErr)
+			}
+			if tt.wantErr {
+				if httpResp != nil || !strings.Contains(diags[0].Summary, "more than 5 pages") || strings.Contains(diags[0].Detail, "200") {
+					t.Errorf("paginateN() = %v, %v, want the page limit without the HTTP response", httpResp, diags)
+				}
+			}
+			if len(items) != tt.wantItems {
+				t.Errorf("paginateN() = %d items, want %d", len(items), tt.wantItems)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("paginateN() made %d calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUnitPaginateError(t *testing.T) {
+
+	wantErr := errors.New("boom")
+	wantResp := &http.Response{StatusCode: 404}
+
+	_, httpResp, diags := paginate(context.Background(), "items", func(page int32, perPage int32) ([]int, *http.Response, error) {
+		return nil, wantResp, wantErr
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "boom") {
+		t.Errorf("paginate() diags = %v, want %v", diags, wantErr)
+	}
+	if httpResp != wantResp {
+		t.Errorf("paginate() did not return the HTTP response of the failed call")
+	}
+}
+
+func TestUnitPaginateCanceled(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, diags := paginate(ctx, "items", func(page int32, perPage int32) ([]int, *http.Response, error) {
+		t.Fatal("paginate() called the API with a canceled context")
+		return nil, nil, nil
+	})
+	if !diags.HasError() || diags[0].Summary != context.Canceled.Error() {
+		t.Errorf("paginate() diags = %v, want %v", diags, context.Canceled)
+	}
+}
@@ -2,19 +2,24 @@ package statuspage
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 	providerVersion "github.com/sbecker59/terraform-provider-statuspage/version"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	retryablehttp "github.com/sbecker59/terraform-provider-statuspage/statuspage/internal/go-retryablehttp"
+	"github.com/sbecker59/terraform-provider-statuspage/statuspage/internal/httplog"
+	"github.com/sbecker59/terraform-provider-statuspage/statuspage/internal/ratelimit"
 )
 
 var (
@@ -29,23 +34,102 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"STATUSPAGE_API_KEY", "SP_API_KEY"}, nil),
 			},
+			"api_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Base URL of the Status Page API, e.g. to target a proxy or a mock server. Can also be set with the STATUSPAGE_API_URL environment variable.",
+				DefaultFunc:  schema.EnvDefaultFunc("STATUSPAGE_API_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			"page_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Default ID of the page used by resources and data sources which do not set page_id. Can also be set with the STATUSPAGE_PAGE_ID environment variable.",
+				DefaultFunc:   schema.EnvDefaultFunc("STATUSPAGE_PAGE_ID", nil),
+				ConflictsWith: []string{"page_name"},
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Default name of the page used by resources and data sources which do not set page_id, resolved to its ID through the pages of the account. Conflicts with page_id, and takes precedence over STATUSPAGE_PAGE_ID. Can also be set with the STATUSPAGE_PAGE_NAME environment variable.",
+				DefaultFunc:   schema.EnvDefaultFunc("STATUSPAGE_PAGE_NAME", nil),
+				ConflictsWith: []string{"page_id"},
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of retries of a failed API request. Set to 0 to disable retries.",
+				Default:      4,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Minimum time to wait between two retries, as a duration such as \"500ms\" or \"1s\".",
+				Default:      "1s",
+				ValidateFunc: validateDuration,
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Maximum time to wait between two retries, as a duration such as \"500ms\" or \"30s\".",
+				Default:      "30s",
+				ValidateFunc: validateDuration,
+			},
+			"retry_on_status": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional HTTP status codes to retry on. 420, 429 and 5xx responses are always retried.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(100, 599),
+				},
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  "Maximum number of API requests per second shared by all resources and data sources. Set to 0 to disable client-side rate limiting.",
+				Default:      1.0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"requests_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of API requests allowed in a single burst above `requests_per_second`.",
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"debug_http": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the HTTP requests sent to the API and their responses at INFO level, with credentials redacted. Exchanges are always logged when TF_LOG is set to DEBUG or TRACE.",
+				Default:     false,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"statuspage_component":       resourceComponent(),
-			"statuspage_component_group": resourceComponentGroup(),
-			"statuspage_incident":        resourceIncident(),
-			"statuspage_metric":          resourceMetric(),
-			"statuspage_metric_provider": resourceMetricProvider(),
-			"statuspage_subscriber":      resourceSubscriber(),
-			"statuspage_page_access_group": resourcePageAccessGroup(),
-			"statuspage_page_access_user": resourcePageAccessUser(),
+			"statuspage_component":                  resourceComponent(),
+			"statuspage_component_group":            resourceComponentGroup(),
+			"statuspage_component_group_membership": resourceComponentGroupMembership(),
+			"statuspage_component_order":            resourceComponentOrder(),
+			"statuspage_incident":                   resourceIncident(),
+			"statuspage_incident_update":            resourceIncidentUpdateEntry(),
+			"statuspage_metric":                     resourceMetric(),
+			"statuspage_metric_provider":            resourceMetricProvider(),
+			"statuspage_scheduled_maintenance":      resourceScheduledMaintenance(),
+			"statuspage_subscriber":                 resourceSubscriber(),
+			"statuspage_third_party_component":      resourceThirdPartyComponent(),
+			"statuspage_page_access_group":          resourcePageAccessGroup(),
+			"statuspage_page_access_user":           resourcePageAccessUser(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"statuspage_component":        dataSourceComponent(),
+			"statuspage_component_uptime": dataSourceComponentUptime(),
+			"statuspage_component_group":  dataSourceComponentGroup(),
 			"statuspage_component_groups": dataSourceComponentGroups(),
 			"statuspage_components":       dataSourceComponents(),
-			"statuspage_pages":       	   dataSourcePages(),
+			"statuspage_pages":            dataSourcePages(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	return statuspageProvider
@@ -53,25 +137,48 @@ func Provider() *schema.Provider {
 
 type ProviderConfiguration struct {
 	StatuspageClientV1 *sp.APIClient
-	AuthV1             context.Context
+	// AuthV1 carries the API key on context.Background(), so requests made
+	// with it are never cancelled. It is kept for the older acceptance test
+	// helpers, new code uses AuthContext.
+	AuthV1 context.Context
 
-	now func() time.Time
+	// PageID is the page used by resources and data sources which do not
+	// set page_id.
+	PageID string
+	// PageName is resolved to the default page when it is set, in place of
+	// PageID.
+	PageName string
+
+	apiKey string
+	now    func() time.Time
+	pages  pageIndex
+	// componentGroupLocks holds a *sync.Mutex per component group, which
+	// serializes the updates of its list of components.
+	componentGroupLocks sync.Map
 }
 
 func (p *ProviderConfiguration) Now() time.Time {
 	return p.now()
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	log.Println("[INFO] Initializing Status Page client")
-	apiKey := d.Get("api_key").(string)
+// AuthContext returns ctx carrying the API key, so that requests made with it
+// are cancelled along with the Terraform operation.
+func (p *ProviderConfiguration) AuthContext(ctx context.Context) context.Context {
+	return authContext(ctx, p.apiKey)
+}
 
-	if apiKey == "" {
-		return nil, errors.New("api_key must be set unless")
-	}
+// lockComponentGroup locks the list of components of a component group until
+// the returned function is called.
+func (p *ProviderConfiguration) lockComponentGroup(groupID string) func() {
+	v, _ := p.componentGroupLocks.LoadOrStore(groupID, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
 
-	authV1 := context.WithValue(
-		context.Background(),
+func authContext(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(
+		ctx,
 		sp.ContextAPIKeys,
 		map[string]sp.APIKey{
 			"api_key": {
@@ -80,15 +187,62 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			},
 		},
 	)
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	log.Println("[INFO] Initializing Status Page client")
+	apiKey := d.Get("api_key").(string)
+
+	if apiKey == "" {
+		return nil, diag.Errorf("api_key must be set unless STATUSPAGE_API_KEY or SP_API_KEY is set")
+	}
+
+	// Both are validated as durations.
+	retryWaitMin, _ := time.ParseDuration(d.Get("retry_wait_min").(string))
+	retryWaitMax, _ := time.ParseDuration(d.Get("retry_wait_max").(string))
+	retryOptions := retryablehttp.Options{
+		RetryWaitMin: retryWaitMin,
+		RetryWaitMax: retryWaitMax,
+		RetryMax:     d.Get("max_retries").(int),
+	}
+	if retryOptions.RetryWaitMin > retryOptions.RetryWaitMax {
+		return nil, diag.Errorf("retry_wait_min must be lower than or equal to retry_wait_max")
+	}
+	for _, statusCode := range d.Get("retry_on_status").([]interface{}) {
+		retryOptions.RetryOnStatus = append(retryOptions.RetryOnStatus, statusCode.(int))
+	}
 
 	config := sp.NewConfiguration()
-	config.HTTPClient = retryablehttp.NewClient().StandardClient()
+	if apiURL := d.Get("api_url").(string); apiURL != "" {
+		log.Printf("[INFO] Using Status Page API URL '%s'", apiURL)
+		config.Servers = sp.ServerConfigurations{
+			{
+				URL:         strings.TrimSuffix(apiURL, "/"),
+				Description: "Status Page API URL set in the provider configuration",
+			},
+		}
+	}
+
+	retryClient := retryablehttp.NewClientWithOptions(retryOptions)
+	if logging.IsDebugOrHigher() {
+		retryClient.HTTPClient.Transport = httplog.NewTransport(retryClient.HTTPClient.Transport, "DEBUG")
+	} else if d.Get("debug_http").(bool) {
+		retryClient.HTTPClient.Transport = httplog.NewTransport(retryClient.HTTPClient.Transport, "INFO")
+	}
+	if requestsPerSecond := d.Get("requests_per_second").(float64); requestsPerSecond > 0 {
+		limiter := ratelimit.NewLimiter(requestsPerSecond, d.Get("requests_burst").(int))
+		retryClient.HTTPClient.Transport = ratelimit.NewTransport(retryClient.HTTPClient.Transport, limiter)
+	}
+	config.HTTPClient = retryClient.StandardClient()
 	config.UserAgent = getUserAgent(config.UserAgent)
 	statuspageClientV1 := sp.NewAPIClient(config)
 
 	return &ProviderConfiguration{
 		StatuspageClientV1: statuspageClientV1,
-		AuthV1:             authV1,
+		AuthV1:             authContext(context.Background(), apiKey),
+		PageID:             d.Get("page_id").(string),
+		PageName:           d.Get("page_name").(string),
+		apiKey:             apiKey,
 		now:                time.Now,
 	}, nil
 
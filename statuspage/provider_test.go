@@ -1,6 +1,7 @@
 package statuspage
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
 var (
@@ -16,6 +18,9 @@ var (
 	pageID                 string
 	pageName               string
 	audienceSpecificPageID string
+	// thirdPartyComponentName is a third-party component added to the page
+	// from the Status Page UI, which the tests remove from the page.
+	thirdPartyComponentName string
 )
 
 func init() {
@@ -26,6 +31,7 @@ func init() {
 	pageID = os.Getenv("STATUSPAGE_PAGE_ID")
 	pageName = os.Getenv("STATUSPAGE_PAGE_NAME")
 	audienceSpecificPageID = os.Getenv("STATUSPAGE_AUDIENCE_SPECIFIC_PAGE_ID")
+	thirdPartyComponentName = os.Getenv("STATUSPAGE_THIRD_PARTY_COMPONENT_NAME")
 }
 
 func isDebug() bool {
@@ -146,3 +152,73 @@ func TestUnitTranslateClientErrorDiag_MsgAndUrlError(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitProviderConfigure_ApiUrl(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key": "api_key",
+		"api_url": "http://localhost:8080/v1/",
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	servers := meta.(*ProviderConfiguration).StatuspageClientV1.GetConfig().Servers
+	if len(servers) != 1 || servers[0].URL != "http://localhost:8080/v1" {
+		t.Errorf("TestUnitProviderConfigure_ApiUrl: unexpected servers %v", servers)
+	}
+}
+
+func TestUnitProviderConfigure_RetryWait(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key":        "api_key",
+		"retry_wait_min": "10s",
+		"retry_wait_max": "5s",
+	})
+
+	if _, diags := providerConfigure(context.Background(), d); !diags.HasError() {
+		t.Error("TestUnitProviderConfigure_RetryWait: expected an error when retry_wait_min is greater than retry_wait_max")
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key":        "api_key",
+		"retry_wait_min": "50ms",
+		"retry_wait_max": "200ms",
+	})
+
+	if _, diags := providerConfigure(context.Background(), d); diags.HasError() {
+		t.Errorf("TestUnitProviderConfigure_RetryWait: unexpected error with sub-second waits: %v", diags)
+	}
+
+	for _, v := range []string{"1", "-1s", "0s", "soon"} {
+		if _, errs := validateDuration(v, "retry_wait_min"); len(errs) == 0 {
+			t.Errorf("TestUnitProviderConfigure_RetryWait: validateDuration accepted %q", v)
+		}
+	}
+}
+
+func TestUnitProviderConfiguration_AuthContext(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key": "api_key",
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	authCtx := meta.(*ProviderConfiguration).AuthContext(ctx)
+	cancel()
+
+	if authCtx.Err() == nil {
+		t.Error("TestUnitProviderConfiguration_AuthContext: expected the auth context to be cancelled with its parent")
+	}
+	if _, ok := authCtx.Value(sp.ContextAPIKeys).(map[string]sp.APIKey); !ok {
+		t.Error("TestUnitProviderConfiguration_AuthContext: expected the auth context to carry the API key")
+	}
+}
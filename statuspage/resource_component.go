@@ -1,50 +1,62 @@
 package statuspage
 
 import (
-	"fmt"
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourceComponentRead(d *schema.ResourceData, m interface{}) error {
+func resourceComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Reading Status Page component '%s'", name)
 
-	component, _, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	component, httpResp, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get component using Status Page API")
-	}
-
-	if &component == nil {
-		log.Printf("[INFO] Statuspage could not find component with ID: %s\n", d.Id())
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "component") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component using Status Page API")
 	}
 
 	d.Set("description", component.GetDescription())
 	d.Set("name", component.GetName())
 	d.Set("only_show_if_degraded", component.GetOnlyShowIfDegraded())
 	d.Set("showcase", component.GetShowcase())
-	d.Set("status", component.GetStatus())
+	d.Set("hidden", component.GetHidden())
+	// Unless Terraform owns the status, it is only recorded when missing from
+	// state, e.g. after an import, so that changes made by incidents or
+	// monitoring do not show up as drift.
+	if componentStatusMode(d) == "terraform" || d.Get("status").(string) == "" {
+		d.Set("status", component.GetStatus())
+	}
 	d.Set("automation_email", component.GetAutomationEmail())
+	d.Set("start_date", normalizeDate(component.GetStartDate()))
+	d.Set("group_id", component.GetGroupId())
+	d.Set("position", component.GetPosition())
 
 	return nil
 }
 
-func resourceComponentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	status := d.Get("status").(string)
@@ -56,33 +68,42 @@ func resourceComponentCreate(d *schema.ResourceData, m interface{}) error {
 
 	component.SetName(name)
 	component.SetDescription(description)
-	component.SetStatus(status)
+	if componentStatusMode(d) != "ignore" {
+		component.SetStatus(status)
+	}
 	component.SetOnlyShowIfDegraded(onlyShowIfDegraded)
 	component.SetShowcase(showcase)
+	component.SetHidden(d.Get("hidden").(bool))
 	if r, ok := d.GetOk("start_date"); ok {
 		component.SetStartDate(r.(string))
 	}
+	if r, ok := d.GetOk("group_id"); ok {
+		component.SetGroupId(r.(string))
+	}
+	if r, ok := d.GetOk("position"); ok {
+		component.SetPosition(int32(r.(int)))
+	}
 
 	o := *sp.NewPostPagesPageIdComponents()
 	o.SetComponent(component)
 
 	log.Printf("[INFO] Creating Status Page componant '%s'", name)
-	result, _, err := statuspageClientV1.ComponentsApi.PostPagesPageIdComponents(authV1, d.Get("page_id").(string)).PostPagesPageIdComponents(o).Execute()
+	result, httpResp, err := statuspageClientV1.ComponentsApi.PostPagesPageIdComponents(authV1, d.Get("page_id").(string)).PostPagesPageIdComponents(o).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create component using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create component using Status Page API")
 	}
 
 	d.SetId(result.GetId())
 
-	return resourceComponentRead(d, m)
+	return resourceComponentRead(ctx, d, m)
 
 }
 
-func resourceComponentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	status := d.Get("status").(string)
@@ -94,75 +115,147 @@ func resourceComponentUpdate(d *schema.ResourceData, m interface{}) error {
 
 	component.SetName(name)
 	component.SetDescription(description)
-	component.SetStatus(status)
+	if componentStatusMode(d) == "terraform" {
+		component.SetStatus(status)
+	}
 	component.SetOnlyShowIfDegraded(onlyShowIfDegraded)
 	component.SetShowcase(showcase)
+	component.SetHidden(d.Get("hidden").(bool))
 	if r, ok := d.GetOk("start_date"); ok {
 		component.SetStartDate(r.(string))
 	}
+	// group_id and position are only sent when they change, so that a
+	// statuspage_component_group managing the same component is not undone.
+	var diags diag.Diagnostics
+	if d.HasChange("group_id") {
+		if old, _ := d.GetChange("group_id"); old.(string) != "" {
+			diags = append(diags, componentGroupMoveWarning(d.Id(), old.(string)))
+		}
+		component.SetGroupId(d.Get("group_id").(string))
+	}
+	if d.HasChange("position") {
+		component.SetPosition(int32(d.Get("position").(int)))
+	}
 
 	o := *sp.NewPatchPagesPageIdComponents()
 	o.SetComponent(component)
 
 	log.Printf("[INFO] Update Status Page componant '%s'", name)
-	result, _, err := statuspageClientV1.ComponentsApi.PatchPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdComponents(o).Execute()
+	result, httpResp, err := statuspageClientV1.ComponentsApi.PatchPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdComponents(o).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to update component using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component using Status Page API")
 	}
 
 	d.SetId(result.GetId())
 
-	return resourceComponentRead(d, m)
+	return append(diags, resourceComponentRead(ctx, d, m)...)
 }
 
-func resourceComponentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, err := statuspageClientV1.ComponentsApi.DeletePagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	httpResp, err := statuspageClientV1.ComponentsApi.DeletePagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete component using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete component using Status Page API")
 	}
 
 	return nil
 }
 
-func resourceComponentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceComponentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 
-	if len(strings.Split(d.Id(), "/")) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/component-id'", d.Id())
+	pageID, componentID, byName, err := parseImportID(d.Id(), "page-id/component-id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
-	pageID := strings.Split(d.Id(), "/")[0]
-	componentID := strings.Split(d.Id(), "/")[1]
+	if byName {
+		log.Printf("[INFO] Looking up Component named '%s' in Page %s", componentID, pageID)
+
+		id, diags := findComponentIDByName(ctx, m, pageID, componentID)
+		if diags.HasError() {
+			return []*schema.ResourceData{}, DiagnosticsError(diags)
+		}
+		componentID = id
+	}
 
 	log.Printf("[INFO] Importing Component %s from Page %s", componentID, pageID)
 
 	d.Set("page_id", pageID)
+	d.Set("status_management", "terraform")
 	d.SetId(componentID)
 
-	err := resourceComponentRead(d, m)
-	return []*schema.ResourceData{d}, err
+	if diags := resourceComponentRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	return []*schema.ResourceData{d}, nil
 
 }
 
+// componentStatusMode returns how the status of the component is managed:
+// "terraform" sends and reads it back, "initial_only" only sends it on
+// creation and "ignore" never sends it.
+func componentStatusMode(d *schema.ResourceData) string {
+	if mode := d.Get("status_management").(string); mode != "" {
+		return mode
+	}
+	return "terraform"
+}
+
+// customizeDiffComponentGroupID plans the removal of the component from its
+// group when group_id is set to "" in the configuration. As group_id is
+// computed, an empty value would otherwise keep the group read from the API.
+func customizeDiffComponentGroupID(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	groupID := rawConfig.GetAttr("group_id")
+	if groupID.IsNull() || !groupID.IsKnown() || groupID.AsString() != "" {
+		return nil
+	}
+	if old, _ := d.GetChange("group_id"); old.(string) == "" {
+		return nil
+	}
+	return d.SetNew("group_id", "")
+}
+
 func resourceComponent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComponentCreate,
-		Read:   resourceComponentRead,
-		Update: resourceComponentUpdate,
-		Delete: resourceComponentDelete,
+		CreateContext: resourceComponentCreate,
+		ReadContext:   resourceComponentRead,
+		UpdateContext: resourceComponentUpdate,
+		DeleteContext: resourceComponentDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceComponentImport,
+			StateContext: resourceComponentImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffPageID,
+			customizeDiffComponentGroupID,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "the ID of the page this component belongs to",
+				Description: "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Description: "Display Name for the component",
@@ -179,6 +272,13 @@ func resourceComponent() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"operational", "under_maintenance", "degraded_performance", "partial_outage", "major_outage", ""}, false),
 				Default:      "operational",
 			},
+			"status_management": {
+				Type:         schema.TypeString,
+				Description:  "how Terraform manages the status of the component: terraform sends it and reports changes made outside of Terraform, initial_only only sets it on creation and ignore never sets it",
+				Optional:     true,
+				Default:      "terraform",
+				ValidateFunc: validation.StringInSlice([]string{"terraform", "ignore", "initial_only"}, false),
+			},
 			"showcase": {
 				Type:        schema.TypeBool,
 				Description: "Should this component be shown component only if in degraded state",
@@ -189,15 +289,37 @@ func resourceComponent() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"start_date": {
-				Type:        schema.TypeString,
-				Description: "Should this component be showcased",
+			"hidden": {
+				Type:        schema.TypeBool,
+				Description: "Should this component be hidden from the page",
 				Optional:    true,
+				Default:     false,
+			},
+			"start_date": {
+				Type:             schema.TypeString,
+				Description:      "the date this component started being used, in YYYY-MM-DD format",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateDate,
+				DiffSuppressFunc: suppressEquivalentDate,
 			},
 			"automation_email": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the component group this component belongs to. Set to \"\" to remove the component from its group. Leave unset when the membership is managed by a statuspage_component_group, a warning is shown when applying moves the component out of another group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Description:  "the order of the component on the page, or in its group",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
@@ -1,161 +1,234 @@
 package statuspage
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourceComponentGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceComponentGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Reading Status Page component '%s'", name)
 
-	componentGroups, _, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	componentGroups, httpResp, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get component groups using Status Page API")
-	}
-
-	if componentGroups == *sp.NewGroupComponent() {
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "component group") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component groups using Status Page API")
 	}
 
 	d.Set("description", componentGroups.Description)
 	d.Set("name", componentGroups.Name)
 	d.Set("components", componentGroups.Components)
+	d.Set("position", componentGroups.GetPosition())
 
 	return nil
 }
 
-func resourceComponentGroupCreate(d *schema.ResourceData, m interface{}) error {
+// componentGroupMoveWarning warns about a component moved out of a component
+// group, which is how a statuspage_component setting group_id and a
+// statuspage_component_group listing the same component undo each other.
+func componentGroupMoveWarning(componentID string, fromGroupID string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("component %s is moved out of component group %s", componentID, fromGroupID),
+		Detail:   "If the group_id of a statuspage_component and the components of a statuspage_component_group both set this membership, each apply moves the component back. Manage it from only one of them.",
+	}
+}
+
+// componentGroupMoves warns about the components which adding componentIDs to
+// the group moves out of another group.
+func componentGroupMoves(groupID string, componentIDs []string, components []sp.Component) diag.Diagnostics {
+	groups := make(map[string]string, len(components))
+	for _, c := range components {
+		groups[c.GetId()] = c.GetGroupId()
+	}
+
+	var diags diag.Diagnostics
+	for _, id := range componentIDs {
+		if from := groups[id]; from != "" && from != groupID {
+			diags = append(diags, componentGroupMoveWarning(id, from))
+		}
+	}
+	return diags
+}
+
+func resourceComponentGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
-	components := d.Get("components").(*schema.Set).List()
-	c := make([]string, len(components))
-	for i, v := range components {
-		c[i] = fmt.Sprint(v)
-	}
-
 	var componentGroup sp.PostPagesPageIdComponentGroupsComponentGroup
 
+	var diags diag.Diagnostics
 	componentGroup.SetName(name)
-	componentGroup.SetComponents(c)
+	if _, ok := d.GetOk("components"); ok {
+		components, listDiags := listComponents(ctx, m, d.Get("page_id").(string))
+		if listDiags.HasError() {
+			return listDiags
+		}
+		diags = componentGroupMoves("", StringListFromSchemaKey(d, "components"), components)
+		componentGroup.SetComponents(StringListFromSchemaKey(d, "components"))
+	}
 	componentGroup.SetDescription(description)
+	if r, ok := d.GetOk("position"); ok {
+		componentGroup.SetPosition(int32(r.(int)))
+	}
 
 	o := *sp.NewPostPagesPageIdComponentGroups()
 	o.SetComponentGroup(componentGroup)
 
 	log.Printf("[INFO] Creating Status Page componant groups '%s'", name)
-	resp, _, err := statuspageClientV1.ComponentGroupsApi.PostPagesPageIdComponentGroups(authV1, d.Get("page_id").(string)).PostPagesPageIdComponentGroups(o).Execute()
+	resp, httpResp, err := statuspageClientV1.ComponentGroupsApi.PostPagesPageIdComponentGroups(authV1, d.Get("page_id").(string)).PostPagesPageIdComponentGroups(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create component groups using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create component groups using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourceComponentGroupRead(d, m)
+	return append(diags, resourceComponentGroupRead(ctx, d, m)...)
 
 }
 
-func resourceComponentGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceComponentGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
-	components := d.Get("components").(*schema.Set).List()
-	c := make([]string, len(components))
-	for i, v := range components {
-		c[i] = fmt.Sprint(v)
-	}
-
 	var componentGroup sp.PostPagesPageIdComponentGroupsComponentGroup
 
+	var diags diag.Diagnostics
 	componentGroup.SetName(name)
-	componentGroup.SetComponents(c)
+	// The components are only sent when they change, so that the members
+	// added by statuspage_component_group_membership resources are kept.
+	if d.HasChange("components") {
+		components, listDiags := listComponents(ctx, m, d.Get("page_id").(string))
+		if listDiags.HasError() {
+			return listDiags
+		}
+		diags = componentGroupMoves(d.Id(), StringListFromSchemaKey(d, "components"), components)
+		componentGroup.SetComponents(StringListFromSchemaKey(d, "components"))
+	}
 	componentGroup.SetDescription(description)
+	if d.HasChange("position") {
+		componentGroup.SetPosition(int32(d.Get("position").(int)))
+	}
 
 	o := *sp.NewPatchPagesPageIdComponentGroups()
 	o.SetComponentGroup(componentGroup)
 
 	log.Printf("[INFO] Update Status Page componant group '%s'", name)
-	resp, _, err := statuspageClientV1.ComponentGroupsApi.PatchPagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdComponentGroups(o).Execute()
+	unlock := providerConf.lockComponentGroup(d.Id())
+	resp, httpResp, err := statuspageClientV1.ComponentGroupsApi.PatchPagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdComponentGroups(o).Execute()
+	unlock()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to update component group using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component group using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourceComponentGroupRead(d, m)
+	return append(diags, resourceComponentGroupRead(ctx, d, m)...)
 }
 
-func resourceComponentGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceComponentGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, _, err := statuspageClientV1.ComponentGroupsApi.DeletePagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	_, httpResp, err := statuspageClientV1.ComponentGroupsApi.DeletePagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete component using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete component using Status Page API")
 	}
 
 	return nil
 }
 
-func resourceComponentGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	if len(strings.Split(d.Id(), "/")) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/component-group-id'", d.Id())
+func resourceComponentGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	pageID, componentGroupID, byName, err := parseImportID(d.Id(), "page-id/component-group-id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
-	pageID := strings.Split(d.Id(), "/")[0]
-	componentGroupID := strings.Split(d.Id(), "/")[1]
+	if byName {
+		log.Printf("[INFO] Looking up Component Group named '%s' in Page %s", componentGroupID, pageID)
+
+		id, diags := findComponentGroupIDByName(ctx, m, pageID, componentGroupID)
+		if diags.HasError() {
+			return []*schema.ResourceData{}, DiagnosticsError(diags)
+		}
+		componentGroupID = id
+	}
 
 	log.Printf("[INFO] Importing Component Group %s from Page %s", componentGroupID, pageID)
 
 	d.Set("page_id", pageID)
 	d.SetId(componentGroupID)
 
-	err := resourceComponentGroupRead(d, m)
+	if diags := resourceComponentGroupRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
 
-	return []*schema.ResourceData{d}, err
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceComponentGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComponentGroupCreate,
-		Read:   resourceComponentGroupRead,
-		Update: resourceComponentGroupUpdate,
-		Delete: resourceComponentGroupDelete,
+		CreateContext: resourceComponentGroupCreate,
+		ReadContext:   resourceComponentGroupRead,
+		UpdateContext: resourceComponentGroupUpdate,
+		DeleteContext: resourceComponentGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceComponentGroupImport,
+			StateContext: resourceComponentGroupImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Description: "the ID of the page this component group belongs to",
-				Required:    true,
+				Description: "the ID of the page this component group belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component group belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Description: "An array with the IDs of the components in this group",
@@ -168,13 +241,21 @@ func resourceComponentGroup() *schema.Resource {
 			},
 			"components": {
 				Type:        schema.TypeSet,
-				Description: "An array with the IDs of the components in this group",
-				Required:    true,
+				Description: "An array with the IDs of the components in this group. Leave unset, or ignore its changes, when members are managed by statuspage_component_group_membership resources. Do not also set group_id on the member statuspage_component resources, a warning is shown when applying moves a component out of another group",
+				Optional:    true,
+				Computed:    true,
 				Set:         schema.HashString,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"position": {
+				Type:         schema.TypeInt,
+				Description:  "the order of the component group on the page",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
@@ -0,0 +1,225 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// updateComponentGroupComponents replaces the components of a group by the
+// result of update, keeping its other attributes. The group is locked so that
+// the memberships of the same group applied in parallel do not overwrite each
+// other. A group which no longer exists is not an error when ignoreMissing is
+// set, as there is nothing left to remove the component from.
+func updateComponentGroupComponents(ctx context.Context, m interface{}, pageID string, groupID string, ignoreMissing bool, update func([]string) []string) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	unlock := providerConf.lockComponentGroup(groupID)
+	defer unlock()
+
+	group, httpResp, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(authV1, pageID, groupID).Execute()
+	if err != nil {
+		if ignoreMissing && httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Statuspage could not find component group %s, nothing to update", groupID)
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component group using Status Page API")
+	}
+
+	components := update(group.GetComponents())
+	if stringSlicesEqual(components, group.GetComponents()) {
+		return nil
+	}
+
+	var componentGroup sp.PostPagesPageIdComponentGroupsComponentGroup
+
+	componentGroup.SetName(group.GetName())
+	componentGroup.SetDescription(group.GetDescription())
+	componentGroup.SetComponents(components)
+
+	o := *sp.NewPatchPagesPageIdComponentGroups()
+	o.SetComponentGroup(componentGroup)
+
+	_, httpResp, err = statuspageClientV1.ComponentGroupsApi.PatchPagesPageIdComponentGroupsId(authV1, pageID, groupID).PatchPagesPageIdComponentGroups(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component group using Status Page API")
+	}
+
+	return nil
+}
+
+// addComponentID appends id to the components of a group, unless it is
+// already a member.
+func addComponentID(components []string, id string) []string {
+	for _, c := range components {
+		if c == id {
+			return components
+		}
+	}
+	return append(append([]string{}, components...), id)
+}
+
+// removeComponentID returns the components of a group without id.
+func removeComponentID(components []string, id string) []string {
+	remaining := []string{}
+	for _, c := range components {
+		if c != id {
+			remaining = append(remaining, c)
+		}
+	}
+	return remaining
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceComponentGroupMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	groupID := d.Get("group_id").(string)
+	componentID := d.Get("component_id").(string)
+	log.Printf("[INFO] Reading Status Page membership of component '%s' in group '%s'", componentID, groupID)
+
+	group, httpResp, err := statuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(authV1, d.Get("page_id").(string), groupID).Execute()
+	if err != nil {
+		if HandleNotFoundError(d, httpResp, "component group membership") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component group using Status Page API")
+	}
+
+	for _, c := range group.GetComponents() {
+		if c == componentID {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Statuspage could not find component %s in component group %s, removing membership from state", componentID, groupID)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceComponentGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := d.Get("group_id").(string)
+	componentID := d.Get("component_id").(string)
+
+	log.Printf("[INFO] Adding Status Page component '%s' to group '%s'", componentID, groupID)
+	diags := updateComponentGroupComponents(ctx, m, d.Get("page_id").(string), groupID, false, func(components []string) []string {
+		return addComponentID(components, componentID)
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", groupID, componentID))
+
+	return resourceComponentGroupMembershipRead(ctx, d, m)
+}
+
+func resourceComponentGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	componentID := d.Get("component_id").(string)
+
+	log.Printf("[INFO] Removing Status Page component '%s' from group '%s'", componentID, groupID)
+	return updateComponentGroupComponents(ctx, m, d.Get("page_id").(string), groupID, true, func(components []string) []string {
+		return removeComponentID(components, componentID)
+	})
+}
+
+func resourceComponentGroupMembershipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/component-group-id/component-id'", d.Id())
+	}
+
+	pageID, groupID, componentID := parts[0], parts[1], parts[2]
+
+	log.Printf("[INFO] Importing Membership of Component %s in Component Group %s from Page %s", componentID, groupID, pageID)
+
+	d.Set("page_id", pageID)
+	d.Set("group_id", groupID)
+	d.Set("component_id", componentID)
+	d.SetId(fmt.Sprintf("%s/%s", groupID, componentID))
+
+	if diags := resourceComponentGroupMembershipRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	if d.Id() == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Component %s is not a member of component group %s", componentID, groupID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceComponentGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComponentGroupMembershipCreate,
+		ReadContext:   resourceComponentGroupMembershipRead,
+		DeleteContext: resourceComponentGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComponentGroupMembershipImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the page of the component group. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page of the component group, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Description:  "the ID of the component group",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"component_id": {
+				Type:         schema.TypeString,
+				Description:  "the ID of the component to add to the group",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccStatuspageComponentGroupMembership_Basic(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckComponentGroupMembershipConfig(rid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("statuspage_component_group_membership.default", "group_id", "statuspage_component_group.default", "id"),
+					resource.TestCheckResourceAttrPair("statuspage_component_group_membership.default", "component_id", "statuspage_component.component_2", "id"),
+					testAccCheckStatuspageComponentGroupMember("statuspage_component_group_membership.default"),
+				),
+			},
+			{
+				ResourceName:      "statuspage_component_group_membership.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["statuspage_component_group_membership.default"]
+					return fmt.Sprintf("%s/%s/%s", pageID, rs.Primary.Attributes["group_id"], rs.Primary.Attributes["component_id"]), nil
+				},
+				ImportStateVerifyIgnore: []string{"page_name"},
+			},
+		},
+	})
+}
+
+func testAccCheckComponentGroupMembershipConfig(rand int) string {
+	return fmt.Sprintf(`
+	variable "component_name" {
+		default = "tf-testacc-component-group-membership-%d"
+	}
+	variable "pageid" {
+		default = "%s"
+	}
+	resource "statuspage_component" "component_1" {
+		page_id = var.pageid
+		name = "${var.component_name}_1"
+	}
+	resource "statuspage_component" "component_2" {
+		page_id = var.pageid
+		name = "${var.component_name}_2"
+	}
+	resource "statuspage_component_group" "default" {
+		page_id     = var.pageid
+		name        = var.component_name
+		description = "Acc. Tests"
+		components  = [statuspage_component.component_1.id]
+
+		lifecycle {
+			ignore_changes = [components]
+		}
+	}
+	resource "statuspage_component_group_membership" "default" {
+		page_id      = var.pageid
+		group_id     = statuspage_component_group.default.id
+		component_id = statuspage_component.component_2.id
+	}
+	`, rand, pageID)
+}
+
+func testAccCheckStatuspageComponentGroupMember(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*ProviderConfiguration)
+		group, _, err := conn.StatuspageClientV1.ComponentGroupsApi.GetPagesPageIdComponentGroupsId(conn.AuthContext(context.Background()), pageID, rs.Primary.Attributes["group_id"]).Execute()
+		if err != nil {
+			return TranslateClientErrorDiag(err, "error retrieving component group")
+		}
+
+		components := group.GetComponents()
+		if len(components) != 2 {
+			return fmt.Errorf("component group has %d components, want 2", len(components))
+		}
+		if components[1] != rs.Primary.Attributes["component_id"] {
+			return fmt.Errorf("component %s is not a member of component group %s", rs.Primary.Attributes["component_id"], group.GetId())
+		}
+		return nil
+	}
+}
+
+func TestUnitComponentGroupMembershipComponents(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		components []string
+		add        []string
+		remove     []string
+	}{
+		{name: "empty", components: []string{}, add: []string{"a"}, remove: []string{}},
+		{name: "member", components: []string{"a", "b"}, add: []string{"a", "b"}, remove: []string{"b"}},
+		{name: "notMember", components: []string{"b", "c"}, add: []string{"b", "c", "a"}, remove: []string{"b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addComponentID(tt.components, "a"); !reflect.DeepEqual(got, tt.add) {
+				t.Errorf("addComponentID() = %v, want %v", got, tt.add)
+			}
+			if got := removeComponentID(tt.components, "a"); !reflect.DeepEqual(got, tt.remove) {
+				t.Errorf("removeComponentID() = %v, want %v", got, tt.remove)
+			}
+		})
+	}
+}
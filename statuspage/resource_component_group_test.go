@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
 func TestAccStatuspageComponentGroup_Basic(t *testing.T) {
@@ -110,3 +112,30 @@ func testAccCheckStatuspageComponentGroupDestroy(s *terraform.State) error {
 	return nil
 
 }
+
+func TestUnitComponentGroupMoves(t *testing.T) {
+
+	component := func(id, groupID string) sp.Component {
+		var c sp.Component
+		c.SetId(id)
+		c.SetGroupId(groupID)
+		return c
+	}
+	components := []sp.Component{
+		component("ungrouped", ""),
+		component("member", "group"),
+		component("other", "other-group"),
+	}
+
+	diags := componentGroupMoves("group", []string{"ungrouped", "member", "other", "deleted"}, components)
+	if len(diags) != 1 {
+		t.Fatalf("componentGroupMoves() = %v, want 1 warning", diags)
+	}
+	if diags[0].Severity != diag.Warning || diags[0].Summary != "component other is moved out of component group other-group" {
+		t.Errorf("componentGroupMoves() = %v, want a warning about other", diags[0])
+	}
+
+	if diags := componentGroupMoves("", []string{"member"}, components); len(diags) != 1 {
+		t.Errorf("componentGroupMoves() for a new group = %v, want 1 warning", diags)
+	}
+}
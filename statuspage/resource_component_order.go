@@ -0,0 +1,248 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// orderedComponent is a component or a component group of a page with its
+// position.
+type orderedComponent struct {
+	position int32
+	group    *sp.GroupComponent
+}
+
+// getComponentPositions returns the components and component groups at the top
+// level of a page by ID. The components of a group are left out, as their
+// position is within the group.
+func getComponentPositions(ctx context.Context, m interface{}, pageID string) (map[string]orderedComponent, diag.Diagnostics) {
+	components, diags := listComponents(ctx, m, pageID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	groups, diags := listComponentGroups(ctx, m, pageID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	positions := make(map[string]orderedComponent, len(components)+len(groups))
+	for _, c := range components {
+		if c.GetGroup() || c.GetGroupId() != "" {
+			continue
+		}
+		positions[c.GetId()] = orderedComponent{position: c.GetPosition()}
+	}
+	for i := range groups {
+		positions[groups[i].GetId()] = orderedComponent{position: groups[i].GetPosition(), group: &groups[i]}
+	}
+
+	return positions, nil
+}
+
+// sortByPosition returns the IDs found in positions, from the lowest to the
+// highest position.
+func sortByPosition(ids []string, positions map[string]orderedComponent) []string {
+	sorted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := positions[id]; ok {
+			sorted = append(sorted, id)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return positions[sorted[i]].position < positions[sorted[j]].position
+	})
+	return sorted
+}
+
+func resourceComponentOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reading Status Page component order of page '%s'", d.Id())
+
+	positions, diags := getComponentPositions(ctx, m, d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only the listed components are read back, so that components which
+	// are not ordered by this resource do not show up as drift.
+	var ids []string
+	for _, v := range d.Get("components").([]interface{}) {
+		ids = append(ids, v.(string))
+	}
+	if len(ids) == 0 {
+		for id := range positions {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+
+	d.Set("page_id", d.Id())
+	d.Set("components", sortByPosition(ids, positions))
+
+	return nil
+}
+
+// applyComponentOrder moves each of ids to its position in the list, through
+// move. Every item is moved, even when it seems in place already: moving one
+// item renumbers the others, so the positions read beforehand are stale.
+func applyComponentOrder(pageID string, ids []string, positions map[string]orderedComponent, move func(id string, current orderedComponent, position int32) diag.Diagnostics) diag.Diagnostics {
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			return diag.Errorf("component or component group %s is listed more than once", id)
+		}
+		seen[id] = true
+
+		if _, ok := positions[id]; !ok {
+			return diag.Errorf("component or component group %s not found at the top level of page %s", id, pageID)
+		}
+	}
+
+	for i, id := range ids {
+		if diags := move(id, positions[id], int32(i+1)); diags.HasError() {
+			return diags
+		}
+	}
+	return nil
+}
+
+func resourceComponentOrderApply(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	pageID := d.Get("page_id").(string)
+
+	positions, diags := getComponentPositions(ctx, m, pageID)
+	if diags.HasError() {
+		return diags
+	}
+
+	var ids []string
+	for _, v := range d.Get("components").([]interface{}) {
+		ids = append(ids, v.(string))
+	}
+
+	diags = applyComponentOrder(pageID, ids, positions, func(id string, current orderedComponent, position int32) diag.Diagnostics {
+		log.Printf("[INFO] Moving Status Page component '%s' to position %d", id, position)
+
+		if current.group != nil {
+			var componentGroup sp.PostPagesPageIdComponentGroupsComponentGroup
+
+			componentGroup.SetName(current.group.GetName())
+			componentGroup.SetDescription(current.group.GetDescription())
+			componentGroup.SetComponents(current.group.GetComponents())
+			componentGroup.SetPosition(position)
+
+			o := *sp.NewPatchPagesPageIdComponentGroups()
+			o.SetComponentGroup(componentGroup)
+
+			_, httpResp, err := statuspageClientV1.ComponentGroupsApi.PatchPagesPageIdComponentGroupsId(authV1, pageID, id).PatchPagesPageIdComponentGroups(o).Execute()
+			if err != nil {
+				return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component group position using Status Page API")
+			}
+			return nil
+		}
+
+		var component sp.PostPagesPageIdComponentsComponent
+
+		component.SetPosition(position)
+
+		o := *sp.NewPatchPagesPageIdComponents()
+		o.SetComponent(component)
+
+		_, httpResp, err := statuspageClientV1.ComponentsApi.PatchPagesPageIdComponentsComponentId(authV1, pageID, id).PatchPagesPageIdComponents(o).Execute()
+		if err != nil {
+			return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component position using Status Page API")
+		}
+		return nil
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(pageID)
+
+	return resourceComponentOrderRead(ctx, d, m)
+}
+
+func resourceComponentOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceComponentOrderApply(ctx, d, m)
+}
+
+func resourceComponentOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The components keep their positions when the order is no longer
+	// managed.
+	log.Printf("[INFO] Removing Status Page component order of page '%s' from state", d.Id())
+	return nil
+}
+
+func resourceComponentOrderImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id'", d.Id())
+	}
+
+	log.Printf("[INFO] Importing Component Order of Page %s", d.Id())
+
+	if diags := resourceComponentOrderRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceComponentOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComponentOrderCreate,
+		ReadContext:   resourceComponentOrderRead,
+		UpdateContext: resourceComponentOrderApply,
+		DeleteContext: resourceComponentOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComponentOrderImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the page of the components. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page of the components, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Description: "the IDs of the components and component groups at the top level of the page, in display order. Components in a group cannot be listed",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
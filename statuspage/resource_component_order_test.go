@@ -0,0 +1,123 @@
+package statuspage
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStatuspageComponentOrder_Basic(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckComponentOrderConfig(rid, "component_1", "component_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_component_order.default", "components.#", "2"),
+					resource.TestCheckResourceAttrPair("statuspage_component_order.default", "components.0", "statuspage_component.component_1", "id"),
+					resource.TestCheckResourceAttrPair("statuspage_component_order.default", "components.1", "statuspage_component.component_2", "id"),
+				),
+			},
+			{
+				Config: testAccCheckComponentOrderConfig(rid, "component_2", "component_1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("statuspage_component_order.default", "components.0", "statuspage_component.component_2", "id"),
+					resource.TestCheckResourceAttrPair("statuspage_component_order.default", "components.1", "statuspage_component.component_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentOrderConfig(rand int, first, second string) string {
+	return fmt.Sprintf(`
+	variable "component_name" {
+		default = "tf-testacc-component-order-%d"
+	}
+	variable "pageid" {
+		default = "%s"
+	}
+	resource "statuspage_component" "component_1" {
+		page_id = var.pageid
+		name = "${var.component_name}_1"
+	}
+	resource "statuspage_component" "component_2" {
+		page_id = var.pageid
+		name = "${var.component_name}_2"
+	}
+	resource "statuspage_component_order" "default" {
+		page_id    = var.pageid
+		components = [statuspage_component.%s.id, statuspage_component.%s.id]
+	}
+	`, rand, pageID, first, second)
+}
+
+func TestUnitSortByPosition(t *testing.T) {
+
+	positions := map[string]orderedComponent{
+		"a": {position: 3},
+		"b": {position: 1},
+		"c": {position: 2},
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want []string
+	}{
+		{name: "all", ids: []string{"a", "b", "c"}, want: []string{"b", "c", "a"}},
+		{name: "subset", ids: []string{"a", "b"}, want: []string{"b", "a"}},
+		{name: "unknown", ids: []string{"a", "d"}, want: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortByPosition(tt.ids, positions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortByPosition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitApplyComponentOrder(t *testing.T) {
+
+	// page renumbers its items on each move, as the API does.
+	page := []string{"a", "b", "c"}
+	positions := map[string]orderedComponent{
+		"a": {position: 1},
+		"b": {position: 2},
+		"c": {position: 3},
+	}
+	move := func(id string, current orderedComponent, position int32) diag.Diagnostics {
+		for i, p := range page {
+			if p == id {
+				page = append(page[:i], page[i+1:]...)
+				break
+			}
+		}
+		page = append(page[:position-1], append([]string{id}, page[position-1:]...)...)
+		return nil
+	}
+
+	if diags := applyComponentOrder("page", []string{"c", "a", "b"}, positions, move); diags.HasError() {
+		t.Fatalf("applyComponentOrder() returned errors: %v", diags)
+	}
+	if want := []string{"c", "a", "b"}; !reflect.DeepEqual(page, want) {
+		t.Errorf("page order = %v, want %v", page, want)
+	}
+
+	if diags := applyComponentOrder("page", []string{"a", "a"}, positions, move); !diags.HasError() {
+		t.Errorf("applyComponentOrder() accepted a duplicate")
+	}
+	if diags := applyComponentOrder("page", []string{"a", "grouped"}, positions, move); !diags.HasError() {
+		t.Errorf("applyComponentOrder() accepted an unknown component")
+	}
+}
@@ -1,6 +1,7 @@
 package statuspage
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -25,6 +26,8 @@ func TestAccStatuspageComponent_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("statuspage_component.default", "description", "test component"),
 					resource.TestCheckResourceAttr("statuspage_component.default", "status", "operational"),
 					resource.TestCheckResourceAttr("statuspage_component.default", "showcase", "true"),
+					resource.TestCheckResourceAttr("statuspage_component.default", "hidden", "false"),
+					resource.TestCheckResourceAttrSet("statuspage_component.default", "position"),
 				),
 			},
 			{
@@ -34,6 +37,8 @@ func TestAccStatuspageComponent_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("statuspage_component.default", "description", "updated component"),
 					resource.TestCheckResourceAttr("statuspage_component.default", "status", "major_outage"),
 					resource.TestCheckResourceAttr("statuspage_component.default", "showcase", "false"),
+					resource.TestCheckResourceAttr("statuspage_component.default", "start_date", "2024-01-01"),
+					resource.TestCheckResourceAttr("statuspage_component.default", "hidden", "true"),
 				),
 			},
 		},
@@ -72,10 +77,75 @@ func testAccCheckComponentConfigUpdated(rand int) string {
 		description = "updated component"
 		status = "major_outage"
 		showcase = false
+		start_date = "2024-01-01"
+		hidden = true
 	}
 	`, rand, pageID)
 }
 
+func TestAccStatuspageComponent_StatusManagement(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckComponentConfigStatusManagement(rid, "major_outage"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_component.default", "status_management", "initial_only"),
+					resource.TestCheckResourceAttr("statuspage_component.default", "status", "major_outage"),
+				),
+			},
+			{
+				Config: testAccCheckComponentConfigStatusManagement(rid, "operational"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_component.default", "status", "operational"),
+					testAccCheckStatuspageComponentStatus("statuspage_component.default", "major_outage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentConfigStatusManagement(rand int, status string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+		default = "tf-testacc-component-%d"
+	}
+	variable "pageid" {
+		default = "%s"
+	}
+	resource "statuspage_component" "default" {
+		page_id = var.pageid
+		name = var.name
+		status = "%s"
+		status_management = "initial_only"
+	}
+	`, rand, pageID, status)
+}
+
+func testAccCheckStatuspageComponentStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*ProviderConfiguration)
+		component, _, err := conn.StatuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(conn.AuthContext(context.Background()), pageID, rs.Primary.ID).Execute()
+		if err != nil {
+			return TranslateClientErrorDiag(err, "error retrieving component")
+		}
+		if component.GetStatus() != status {
+			return fmt.Errorf("component status is %s, want %s", component.GetStatus(), status)
+		}
+		return nil
+	}
+}
+
 func testAccCheckStatuspageComponentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ProviderConfiguration)
 	statuspageClientV1 := conn.StatuspageClientV1
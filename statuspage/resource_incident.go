@@ -1,33 +1,33 @@
 package statuspage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourceIncidentRead(d *schema.ResourceData, m interface{}) error {
+func resourceIncidentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Reading Status Page incident '%s'", name)
 
-	incident, _, err := statuspageClientV1.IncidentsApi.GetPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	incident, httpResp, err := statuspageClientV1.IncidentsApi.GetPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get incident using Status Page API")
-	}
-
-	if &incident == nil {
-		log.Printf("[INFO] Statuspage could not find incident with ID: %s\n", d.Id())
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "incident") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get incident using Status Page API")
 	}
 
 	d.Set("name", incident.GetName())
@@ -50,11 +50,15 @@ func resourceIncidentRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceIncidentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIncidentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	status := d.Get("status").(string)
@@ -99,23 +103,23 @@ func resourceIncidentCreate(d *schema.ResourceData, m interface{}) error {
 	o.SetIncident(component)
 
 	log.Printf("[INFO] Creating Status Page incident '%s'", name)
-	result, _, err := statuspageClientV1.IncidentsApi.PostPagesPageIdIncidents(authV1, d.Get("page_id").(string)).PostPagesPageIdIncidents(o).Execute()
+	result, httpResp, err := statuspageClientV1.IncidentsApi.PostPagesPageIdIncidents(authV1, d.Get("page_id").(string)).PostPagesPageIdIncidents(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create incident using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create incident using Status Page API")
 	}
 
 	d.SetId(result.GetId())
 
-	return resourceIncidentRead(d, m)
+	return resourceIncidentRead(ctx, d, m)
 
 }
 
-func resourceIncidentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIncidentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	status := d.Get("status").(string)
@@ -160,32 +164,32 @@ func resourceIncidentUpdate(d *schema.ResourceData, m interface{}) error {
 	o.SetIncident(component)
 
 	log.Printf("[INFO] Update Status Page incident '%s'", name)
-	result, _, err := statuspageClientV1.IncidentsApi.PatchPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdIncidents(o).Execute()
+	result, httpResp, err := statuspageClientV1.IncidentsApi.PatchPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdIncidents(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to update incident using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update incident using Status Page API")
 	}
 
 	d.SetId(result.GetId())
 
-	return resourceIncidentRead(d, m)
+	return resourceIncidentRead(ctx, d, m)
 }
 
-func resourceIncidentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIncidentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, _, err := statuspageClientV1.IncidentsApi.DeletePagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	_, httpResp, err := statuspageClientV1.IncidentsApi.DeletePagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete incident using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete incident using Status Page API")
 	}
 
 	return nil
 }
 
-func resourceIncidentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceIncidentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 
 	if len(strings.Split(d.Id(), "/")) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/incident-id'", d.Id())
@@ -199,27 +203,44 @@ func resourceIncidentImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	d.Set("page_id", pageID)
 	d.SetId(incidentID)
 
-	err := resourceIncidentRead(d, m)
-	return []*schema.ResourceData{d}, err
+	if diags := resourceIncidentRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	return []*schema.ResourceData{d}, nil
 
 }
 
 func resourceIncident() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIncidentCreate,
-		Read:   resourceIncidentRead,
-		Update: resourceIncidentUpdate,
-		Delete: resourceIncidentDelete,
+		CreateContext: resourceIncidentCreate,
+		ReadContext:   resourceIncidentRead,
+		UpdateContext: resourceIncidentUpdate,
+		DeleteContext: resourceIncidentDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceIncidentImport,
+			StateContext: resourceIncidentImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "the ID of the page this component belongs to",
+				Description: "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Description: "Incident Name",
@@ -228,7 +249,7 @@ func resourceIncident() *schema.Resource {
 			"status": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The incident status. For realtime incidents, valid values are investigating, identified, monitoring, and resolved. For scheduled incidents, valid values are scheduled, in_progress, verifying, and completed.",
+				Description:  "The incident status, one of investigating, identified, monitoring and resolved. Use statuspage_scheduled_maintenance for scheduled maintenances.",
 				ValidateFunc: validation.StringInSlice([]string{"investigating", "identified", "monitoring", "resolved"}, false),
 				Default:      "investigating",
 			},
@@ -0,0 +1,314 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// An incident update is posted by updating the status or the body of its
+// incident. The API can edit the text of an update but cannot delete it, so
+// destroying the resource only removes it from the state.
+
+// findIncidentUpdate returns the update of an incident with the given ID.
+func findIncidentUpdate(updates []sp.IncidentUpdate, id string) (*sp.IncidentUpdate, bool) {
+	for i := range updates {
+		if updates[i].GetId() == id {
+			return &updates[i], true
+		}
+	}
+	return nil, false
+}
+
+// latestIncidentUpdate returns the most recent update of an incident with the
+// given status and body, i.e. the one just posted.
+func latestIncidentUpdate(updates []sp.IncidentUpdate, status string, body string) (*sp.IncidentUpdate, bool) {
+	var latest *sp.IncidentUpdate
+	for i := range updates {
+		u := &updates[i]
+		if u.GetStatus() != status || u.GetBody() != body {
+			continue
+		}
+		if latest == nil || u.GetCreatedAt().After(latest.GetCreatedAt()) {
+			latest = u
+		}
+	}
+	return latest, latest != nil
+}
+
+// getIncidentUpdate returns the update of the incident in state. It returns
+// no update and removes the resource from state when either is gone.
+func getIncidentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (*sp.IncidentUpdate, diag.Diagnostics) {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	incidentID := d.Get("incident_id").(string)
+	log.Printf("[INFO] Reading Status Page incident update '%s' of incident '%s'", d.Id(), incidentID)
+
+	incident, httpResp, err := statuspageClientV1.IncidentsApi.GetPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), incidentID).Execute()
+	if err != nil {
+		if HandleNotFoundError(d, httpResp, "incident update") {
+			return nil, nil
+		}
+		return nil, TranslateClientErrorDiagnostics(err, httpResp, "failed to get incident using Status Page API")
+	}
+
+	update, ok := findIncidentUpdate(incident.GetIncidentUpdates(), d.Id())
+	if !ok {
+		log.Printf("[WARN] Statuspage could not find update %s of incident %s, removing it from state", d.Id(), incidentID)
+		d.SetId("")
+		return nil, nil
+	}
+	return update, nil
+}
+
+// flattenAffectedComponents returns the components affected by an update.
+func flattenAffectedComponents(update *sp.IncidentUpdate) []interface{} {
+	components := make([]interface{}, len(update.GetAffectedComponents()))
+	for i, c := range update.GetAffectedComponents() {
+		components[i] = map[string]interface{}{
+			"id":     c.GetCode(),
+			"status": c.GetNewStatus(),
+		}
+	}
+	return components
+}
+
+func resourceIncidentUpdateEntryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	update, diags := getIncidentUpdate(ctx, d, m)
+	if update == nil {
+		return diags
+	}
+
+	d.Set("status", update.GetStatus())
+	d.Set("body", update.GetBody())
+	d.Set("display_at", formatTime(update.GetDisplayAt()))
+	d.Set("created_at", formatTime(update.GetCreatedAt()))
+	// The affected components cannot change once posted, and the API may
+	// leave out those whose status the update did not change, so they are
+	// only read on import.
+
+	return nil
+}
+
+func resourceIncidentUpdateEntryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	incidentID := d.Get("incident_id").(string)
+	status := d.Get("status").(string)
+	body := d.Get("body").(string)
+
+	var componentIDs []string
+	components := make(map[string]interface{})
+	for _, c := range d.Get("component").(*schema.Set).List() {
+		component := c.(map[string]interface{})
+		componentIDs = append(componentIDs, component["id"].(string))
+		components[component["id"].(string)] = component["status"].(string)
+	}
+
+	var incident sp.PatchPagesPageIdIncidentsIncident
+
+	incident.SetStatus(status)
+	incident.SetBody(body)
+	incident.SetDeliverNotifications(d.Get("deliver_notifications").(bool))
+	if len(componentIDs) > 0 {
+		incident.SetComponentIds(componentIDs)
+		incident.SetComponents(components)
+	}
+
+	o := *sp.NewPatchPagesPageIdIncidents()
+	o.SetIncident(incident)
+
+	log.Printf("[INFO] Posting Status Page update of incident '%s'", incidentID)
+	result, httpResp, err := statuspageClientV1.IncidentsApi.PatchPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), incidentID).PatchPagesPageIdIncidents(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update incident using Status Page API")
+	}
+
+	update, ok := latestIncidentUpdate(result.GetIncidentUpdates(), status, body)
+	if !ok {
+		return diag.Errorf("the update posted to incident %s was not found in its updates", incidentID)
+	}
+
+	d.SetId(update.GetId())
+
+	if _, ok := d.GetOk("display_at"); ok {
+		return resourceIncidentUpdateEntryUpdate(ctx, d, m)
+	}
+
+	return resourceIncidentUpdateEntryRead(ctx, d, m)
+}
+
+func resourceIncidentUpdateEntryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	var update sp.PatchPagesPageIdIncidentsIncidentIdIncidentUpdatesIncidentUpdate
+
+	update.SetBody(d.Get("body").(string))
+	update.SetDeliverNotifications(d.Get("deliver_notifications").(bool))
+	if v, ok := d.GetOk("display_at"); ok {
+		// Validated as an RFC 3339 timestamp.
+		displayAt, _ := time.Parse(time.RFC3339, v.(string))
+		update.SetDisplayAt(displayAt)
+	}
+
+	o := *sp.NewPatchPagesPageIdIncidentsIncidentIdIncidentUpdates()
+	o.SetIncidentUpdate(update)
+
+	log.Printf("[INFO] Update Status Page incident update '%s'", d.Id())
+	_, httpResp, err := statuspageClientV1.IncidentUpdatesApi.PatchPagesPageIdIncidentsIncidentIdIncidentUpdatesIncidentUpdateId(authV1, d.Get("page_id").(string), d.Get("incident_id").(string), d.Id()).PatchPagesPageIdIncidentsIncidentIdIncidentUpdates(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update incident update using Status Page API")
+	}
+
+	return resourceIncidentUpdateEntryRead(ctx, d, m)
+}
+
+func resourceIncidentUpdateEntryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Status Page incident updates cannot be deleted, removing update '%s' from state only", d.Id())
+	return nil
+}
+
+func resourceIncidentUpdateEntryImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/incident-id/update-id'", d.Id())
+	}
+
+	pageID, incidentID, updateID := parts[0], parts[1], parts[2]
+
+	log.Printf("[INFO] Importing Update %s of Incident %s from Page %s", updateID, incidentID, pageID)
+
+	d.Set("page_id", pageID)
+	d.Set("incident_id", incidentID)
+	d.Set("deliver_notifications", true)
+	d.SetId(updateID)
+
+	update, diags := getIncidentUpdate(ctx, d, m)
+	if diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	if update == nil {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Update %s not found in incident %s", updateID, incidentID)
+	}
+	d.Set("component", flattenAffectedComponents(update))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIncidentUpdateEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIncidentUpdateEntryCreate,
+		ReadContext:   resourceIncidentUpdateEntryRead,
+		UpdateContext: resourceIncidentUpdateEntryUpdate,
+		DeleteContext: resourceIncidentUpdateEntryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIncidentUpdateEntryImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the page of the incident. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page of the incident, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
+			"incident_id": {
+				Type:         schema.TypeString,
+				Description:  "the ID of the incident or scheduled maintenance",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "the status of the incident set by the update, e.g. identified for a realtime incident or in_progress for a scheduled maintenance",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice(
+					append([]string{"investigating", "identified", "monitoring", "resolved"}, maintenanceStatuses...),
+					false,
+				),
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Description:  "the message of the update. Changing it edits the update in place",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"component": {
+				Type:        schema.TypeSet,
+				Description: "the components affected by the update, and their new status. They are not read back from the API, except on import",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Description:  "Identifier for component",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Description:  "Status of component",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"operational", "under_maintenance", "degraded_performance", "partial_outage", "major_outage"}, false),
+						},
+					},
+				},
+			},
+			"deliver_notifications": {
+				Type:        schema.TypeBool,
+				Description: "Notify subscribers of the update",
+				Optional:    true,
+				Default:     true,
+			},
+			"display_at": {
+				Type:             schema.TypeString,
+				Description:      "the time shown for the update, in RFC 3339 format. Defaults to the time it was posted",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "the time the update was posted",
+				Computed:    true,
+			},
+		},
+	}
+}
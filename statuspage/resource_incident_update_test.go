@@ -0,0 +1,127 @@
+package statuspage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+func TestAccStatuspageIncidentUpdate_Basic(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageIncidentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncidentUpdateConfig(rid, "We found the cause."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("statuspage_incident_update.identified", "id"),
+					resource.TestCheckResourceAttrPair("statuspage_incident_update.identified", "incident_id", "statuspage_incident.default", "id"),
+					resource.TestCheckResourceAttr("statuspage_incident_update.identified", "status", "identified"),
+					resource.TestCheckResourceAttr("statuspage_incident_update.identified", "body", "We found the cause."),
+					resource.TestCheckResourceAttr("statuspage_incident_update.identified", "component.#", "1"),
+					resource.TestCheckResourceAttrSet("statuspage_incident_update.identified", "created_at"),
+				),
+			},
+			{
+				Config:             testAccCheckIncidentUpdateConfig(rid, "We found the cause."),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccCheckIncidentUpdateConfig(rid, "We found the cause, a fix is being deployed."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_incident_update.identified", "body", "We found the cause, a fix is being deployed."),
+				),
+			},
+			{
+				ResourceName:      "statuspage_incident_update.identified",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["statuspage_incident_update.identified"]
+					return fmt.Sprintf("%s/%s/%s", pageID, rs.Primary.Attributes["incident_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"page_name", "deliver_notifications"},
+			},
+		},
+	})
+}
+
+func testAccCheckIncidentUpdateConfig(rand int, body string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+		default = "tf-testacc-incident-update-%d"
+	}
+	variable "pageid" {
+		default = "%s"
+	}
+	resource "statuspage_component" "my_component" {
+		page_id = var.pageid
+		name    = var.name
+	}
+	resource "statuspage_incident" "default" {
+		page_id = var.pageid
+		name    = var.name
+		status  = "investigating"
+		body    = "-"
+
+		lifecycle {
+			ignore_changes = [status, body, component]
+		}
+	}
+	resource "statuspage_incident_update" "identified" {
+		page_id               = var.pageid
+		incident_id           = statuspage_incident.default.id
+		status                = "identified"
+		body                  = "%s"
+		deliver_notifications = false
+
+		component {
+			id     = statuspage_component.my_component.id
+			status = "partial_outage"
+		}
+	}
+	`, rand, pageID, body)
+}
+
+func TestUnitIncidentUpdates(t *testing.T) {
+
+	update := func(id, status, body string, createdAt time.Time) sp.IncidentUpdate {
+		var u sp.IncidentUpdate
+		u.SetId(id)
+		u.SetStatus(status)
+		u.SetBody(body)
+		u.SetCreatedAt(createdAt)
+		return u
+	}
+	now := time.Now()
+	updates := []sp.IncidentUpdate{
+		update("c", "identified", "Fixed", now.Add(2*time.Minute)),
+		update("b", "identified", "Found it", now.Add(time.Minute)),
+		update("a", "investigating", "Looking", now),
+		update("old", "identified", "Found it", now.Add(-time.Hour)),
+	}
+
+	if u, ok := findIncidentUpdate(updates, "a"); !ok || u.GetBody() != "Looking" {
+		t.Errorf("findIncidentUpdate(a) = %v, %v", u, ok)
+	}
+	if _, ok := findIncidentUpdate(updates, "missing"); ok {
+		t.Errorf("findIncidentUpdate(missing) found an update")
+	}
+
+	if u, ok := latestIncidentUpdate(updates, "identified", "Found it"); !ok || u.GetId() != "b" {
+		t.Errorf("latestIncidentUpdate() = %v, %v, want b", u, ok)
+	}
+	if _, ok := latestIncidentUpdate(updates, "resolved", "Found it"); ok {
+		t.Errorf("latestIncidentUpdate() found an update with another status")
+	}
+}
@@ -1,25 +1,27 @@
 package statuspage
 
 import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourceMetricProviderRead(d *schema.ResourceData, m interface{}) error {
+func resourceMetricProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	metricProvider, _, err := statuspageClientV1.MetricProvidersApi.GetPagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	metricProvider, httpResp, err := statuspageClientV1.MetricProvidersApi.GetPagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get metric provider using Status Page API")
-	}
-
-	if &metricProvider == nil {
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "metric provider") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get metric provider using Status Page API")
 	}
 
 	d.Set("type", metricProvider.Type)
@@ -28,11 +30,15 @@ func resourceMetricProviderRead(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func resourceMetricProviderCreate(d *schema.ResourceData, m interface{}) error {
+func resourceMetricProviderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	email := d.Get("email").(string)
 	password := d.Get("password").(string)
@@ -55,21 +61,21 @@ func resourceMetricProviderCreate(d *schema.ResourceData, m interface{}) error {
 	o := *sp.NewPostPagesPageIdMetricsProviders()
 	o.SetMetricsProvider(metricProvider)
 
-	resp, _, err := statuspageClientV1.MetricProvidersApi.PostPagesPageIdMetricsProviders(authV1, d.Get("page_id").(string)).PostPagesPageIdMetricsProviders(o).Execute()
+	resp, httpResp, err := statuspageClientV1.MetricProvidersApi.PostPagesPageIdMetricsProviders(authV1, d.Get("page_id").(string)).PostPagesPageIdMetricsProviders(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create metric provider using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create metric provider using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourceMetricProviderRead(d, m)
+	return resourceMetricProviderRead(ctx, d, m)
 }
 
-func resourceMetricProviderUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceMetricProviderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	metricBaseURI := d.Get("metric_base_uri").(string)
 	metricType := d.Get("type").(string)
@@ -82,26 +88,26 @@ func resourceMetricProviderUpdate(d *schema.ResourceData, m interface{}) error {
 	o := *sp.NewPatchPagesPageIdMetricsProviders()
 	o.SetMetricsProvider(metricProvider)
 
-	resp, _, err := statuspageClientV1.MetricProvidersApi.PatchPagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdMetricsProviders(o).Execute()
+	resp, httpResp, err := statuspageClientV1.MetricProvidersApi.PatchPagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdMetricsProviders(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create metric provider using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create metric provider using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourceMetricProviderRead(d, m)
+	return resourceMetricProviderRead(ctx, d, m)
 }
 
-func resourceMetricProviderDelete(d *schema.ResourceData, m interface{}) error {
+func resourceMetricProviderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, _, err := statuspageClientV1.MetricProvidersApi.DeletePagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	_, httpResp, err := statuspageClientV1.MetricProvidersApi.DeletePagesPageIdMetricsProvidersMetricsProviderId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete component using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete component using Status Page API")
 	}
 
 	return nil
@@ -110,17 +116,32 @@ func resourceMetricProviderDelete(d *schema.ResourceData, m interface{}) error {
 
 func resourceMetricProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceMetricProviderCreate,
-		Read:   resourceMetricProviderRead,
-		Update: resourceMetricProviderUpdate,
-		Delete: resourceMetricProviderDelete,
+		CreateContext: resourceMetricProviderCreate,
+		ReadContext:   resourceMetricProviderRead,
+		UpdateContext: resourceMetricProviderUpdate,
+		DeleteContext: resourceMetricProviderDelete,
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Description: "the ID of the page this component group belongs to",
-				Required:    true,
+				Description: "the ID of the page this component group belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component group belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"email": {
 				Type:        schema.TypeString,
 				Description: "Required by the Librato and Pingdom type metrics providers",
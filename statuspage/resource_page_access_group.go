@@ -1,31 +1,32 @@
 package statuspage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourcePageAccessGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	log.Printf("[INFO] Reading Status Page component '%s'", name)
 
-	pageAccessGroups, _, err := statuspageClientV1.PageAccessGroupsApi.GetPagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	pageAccessGroups, httpResp, err := statuspageClientV1.PageAccessGroupsApi.GetPagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get component groups using Status Page API")
-	}
-
-	if &pageAccessGroups == nil {
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "page access group") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component groups using Status Page API")
 	}
 
 	d.Set("external_identifier", pageAccessGroups.ExternalIdentifier)
@@ -37,11 +38,15 @@ func resourcePageAccessGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourcePageAccessGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	externalIdentifier := d.Get("external_identifier").(string)
@@ -58,23 +63,23 @@ func resourcePageAccessGroupCreate(d *schema.ResourceData, m interface{}) error
 	o.SetPageAccessGroup(pageAccessGroup)
 
 	log.Printf("[INFO] Creating Status Page componant groups '%s'", name)
-	resp, _, err := statuspageClientV1.PageAccessGroupsApi.PostPagesPageIdPageAccessGroups(authV1, d.Get("page_id").(string)).PostPagesPageIdPageAccessGroups(o).Execute()
+	resp, httpResp, err := statuspageClientV1.PageAccessGroupsApi.PostPagesPageIdPageAccessGroups(authV1, d.Get("page_id").(string)).PostPagesPageIdPageAccessGroups(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create component groups using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create component groups using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourcePageAccessGroupRead(d, m)
+	return resourcePageAccessGroupRead(ctx, d, m)
 
 }
 
-func resourcePageAccessGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	name := d.Get("name").(string)
 	externalIdentifier := d.Get("external_identifier").(string)
@@ -91,32 +96,32 @@ func resourcePageAccessGroupUpdate(d *schema.ResourceData, m interface{}) error
 	o.SetPageAccessGroup(pageAccessGroup)
 
 	log.Printf("[INFO] Update Status Page componant group '%s'", name)
-	resp, _, err := statuspageClientV1.PageAccessGroupsApi.PatchPagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdPageAccessGroups(o).Execute()
+	resp, httpResp, err := statuspageClientV1.PageAccessGroupsApi.PatchPagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdPageAccessGroups(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to update component group using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update component group using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourcePageAccessGroupRead(d, m)
+	return resourcePageAccessGroupRead(ctx, d, m)
 }
 
-func resourcePageAccessGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, _, err := statuspageClientV1.PageAccessGroupsApi.DeletePagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	_, httpResp, err := statuspageClientV1.PageAccessGroupsApi.DeletePagesPageIdPageAccessGroupsPageAccessGroupId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete page access group using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete page access group using Status Page API")
 	}
 
 	return nil
 }
 
-func resourcePageAccessGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourcePageAccessGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	if len(strings.Split(d.Id(), "/")) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/component-group-id'", d.Id())
 	}
@@ -129,27 +134,44 @@ func resourcePageAccessGroupImport(d *schema.ResourceData, m interface{}) ([]*sc
 	d.Set("page_id", pageID)
 	d.SetId(pageAccessGroupID)
 
-	err := resourcePageAccessGroupRead(d, m)
+	if diags := resourcePageAccessGroupRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
 
-	return []*schema.ResourceData{d}, err
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourcePageAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePageAccessGroupCreate,
-		Read:   resourcePageAccessGroupRead,
-		Update: resourcePageAccessGroupUpdate,
-		Delete: resourcePageAccessGroupDelete,
+		CreateContext: resourcePageAccessGroupCreate,
+		ReadContext:   resourcePageAccessGroupRead,
+		UpdateContext: resourcePageAccessGroupUpdate,
+		DeleteContext: resourcePageAccessGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourcePageAccessGroupImport,
+			StateContext: resourcePageAccessGroupImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Description: "the ID of the page this component group belongs to",
-				Required:    true,
+				Description: "the ID of the page this component group belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component group belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Description: "Name for this Group.",
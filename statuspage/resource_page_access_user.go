@@ -1,50 +1,59 @@
 package statuspage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourcePageAccessUserRead(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	email := d.Get("email").(string)
 	log.Printf("[INFO] Looking up user by email '%s'", email)
 
-	pageAccessUsers, _, err := statuspageClientV1.PageAccessUsersApi.GetPagesPageIdPageAccessUsers(authV1, d.Get("page_id").(string)).Page(1).PerPage(100).Execute()
+	pageAccessUsers, httpResp, diags := paginate(ctx, "page access users", func(page int32, perPage int32) ([]sp.PageAccessUser, *http.Response, error) {
+		return statuspageClientV1.PageAccessUsersApi.GetPagesPageIdPageAccessUsers(authV1, d.Get("page_id").(string)).Page(page).PerPage(perPage).Execute()
+	})
 
-	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get page access users using Status Page API")
-	}
-
-	if &pageAccessUsers == nil {
-		d.SetId("")
-		return nil
+	if diags.HasError() {
+		if HandleNotFoundError(d, httpResp, "page access user") {
+			return nil
+		}
+		return diags
 	}
 
 	for _, u := range pageAccessUsers {
-		if email == *u.Email {
-			d.SetId(*u.Id)
-			break
-		} else {
-			d.SetId("")
+		if email == u.GetEmail() {
+			d.SetId(u.GetId())
+			return nil
 		}
 	}
 
+	log.Printf("[WARN] Statuspage could not find page access user with email %s, removing it from state", email)
+	d.SetId("")
+
 	return nil
 }
 
-func resourcePageAccessUserCreate(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	email := d.Get("email").(string)
 
@@ -56,33 +65,33 @@ func resourcePageAccessUserCreate(d *schema.ResourceData, m interface{}) error {
 	o.SetPageAccessUser(pageAccessUser)
 
 	log.Printf("[INFO] Creating Status Page access user '%s'", email)
-	resp, _, err := statuspageClientV1.PageAccessUsersApi.PostPagesPageIdPageAccessUsers(authV1, d.Get("page_id").(string)).PostPagesPageIdPageAccessUsers(o).Execute()
+	resp, httpResp, err := statuspageClientV1.PageAccessUsersApi.PostPagesPageIdPageAccessUsers(authV1, d.Get("page_id").(string)).PostPagesPageIdPageAccessUsers(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create page access user using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create page access user using Status Page API")
 	}
 
 	d.SetId(resp.GetId())
 
-	return resourcePageAccessUserRead(d, m)
+	return resourcePageAccessUserRead(ctx, d, m)
 
 }
 
-func resourcePageAccessUserDelete(d *schema.ResourceData, m interface{}) error {
+func resourcePageAccessUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, err := statuspageClientV1.PageAccessUsersApi.DeletePagesPageIdPageAccessUsersPageAccessUserId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	httpResp, err := statuspageClientV1.PageAccessUsersApi.DeletePagesPageIdPageAccessUsersPageAccessUserId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete page access user using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete page access user using Status Page API")
 	}
 
 	return nil
 }
 
-func resourcePageAccessUserImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourcePageAccessUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	if len(strings.Split(d.Id(), "/")) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/email-address'", d.Id())
 	}
@@ -95,26 +104,42 @@ func resourcePageAccessUserImport(d *schema.ResourceData, m interface{}) ([]*sch
 	d.Set("page_id", pageID)
 	d.Set("email", email)
 
-	err := resourcePageAccessUserRead(d, m)
+	if diags := resourcePageAccessUserRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
 
-	return []*schema.ResourceData{d}, err
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourcePageAccessUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePageAccessUserCreate,
-		Read:   resourcePageAccessUserRead,
-		Delete: resourcePageAccessUserDelete,
+		CreateContext: resourcePageAccessUserCreate,
+		ReadContext:   resourcePageAccessUserRead,
+		DeleteContext: resourcePageAccessUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourcePageAccessUserImport,
+			StateContext: resourcePageAccessUserImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Description: "the ID of the page this user belongs to",
-				Required:    true,
+				Description: "the ID of the page this user belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this user belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"email": {
 				Type:        schema.TypeString,
 				Description: "The email of the user",
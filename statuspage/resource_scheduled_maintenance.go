@@ -0,0 +1,378 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// Scheduled maintenances are incidents with a schedule, created and updated
+// through the incident endpoints.
+
+// maintenanceStatuses is the lifecycle of a scheduled maintenance, in order.
+var maintenanceStatuses = []string{"scheduled", "in_progress", "verifying", "completed"}
+
+func maintenanceStatusRank(status string) int {
+	for i, s := range maintenanceStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// scheduledMaintenanceRequest is the incident sent to create or to update a
+// scheduled maintenance.
+type scheduledMaintenanceRequest interface {
+	SetName(string)
+	SetStatus(string)
+	SetImpactOverride(string)
+	SetScheduledFor(time.Time)
+	SetScheduledUntil(time.Time)
+	SetScheduledRemindPrior(bool)
+	SetScheduledAutoInProgress(bool)
+	SetScheduledAutoCompleted(bool)
+	SetAutoTransitionToMaintenanceState(bool)
+	SetAutoTransitionToOperationalState(bool)
+	SetAutoTransitionDeliverNotificationsAtStart(bool)
+	SetAutoTransitionDeliverNotificationsAtEnd(bool)
+	SetDeliverNotifications(bool)
+	SetComponentIds([]string)
+	SetBody(string)
+}
+
+// expandScheduledMaintenance fills the incident sent for a scheduled
+// maintenance. The body is posted as a new incident update, so it is only sent
+// on creation and when it changed.
+func expandScheduledMaintenance(d *schema.ResourceData, r scheduledMaintenanceRequest, update bool) {
+	r.SetName(d.Get("name").(string))
+	r.SetStatus(d.Get("status").(string))
+	r.SetImpactOverride(d.Get("impact_override").(string))
+
+	// Both are validated as RFC 3339 timestamps.
+	scheduledFor, _ := time.Parse(time.RFC3339, d.Get("scheduled_for").(string))
+	scheduledUntil, _ := time.Parse(time.RFC3339, d.Get("scheduled_until").(string))
+	r.SetScheduledFor(scheduledFor)
+	r.SetScheduledUntil(scheduledUntil)
+
+	r.SetScheduledRemindPrior(d.Get("scheduled_remind_prior").(bool))
+	r.SetScheduledAutoInProgress(d.Get("scheduled_auto_in_progress").(bool))
+	r.SetScheduledAutoCompleted(d.Get("scheduled_auto_completed").(bool))
+	r.SetAutoTransitionToMaintenanceState(d.Get("auto_transition_to_maintenance_state").(bool))
+	r.SetAutoTransitionToOperationalState(d.Get("auto_transition_to_operational_state").(bool))
+	r.SetAutoTransitionDeliverNotificationsAtStart(d.Get("auto_transition_deliver_notifications_at_start").(bool))
+	r.SetAutoTransitionDeliverNotificationsAtEnd(d.Get("auto_transition_deliver_notifications_at_end").(bool))
+	r.SetDeliverNotifications(d.Get("deliver_notifications").(bool))
+	r.SetComponentIds(StringListFromSchemaKey(d, "component_ids"))
+
+	if body := d.Get("body").(string); body != "" && (!update || d.HasChange("body")) {
+		r.SetBody(body)
+	}
+}
+
+// suppressAutoTransitionedStatus suppresses the diff of a status that Status
+// Page moved forward on its own, because of scheduled_auto_in_progress or
+// scheduled_auto_completed.
+func suppressAutoTransitionedStatus(k, old, new string, d *schema.ResourceData) bool {
+	autoInProgress := d.Get("scheduled_auto_in_progress").(bool)
+	autoCompleted := d.Get("scheduled_auto_completed").(bool)
+
+	switch {
+	case new == "scheduled" && old == "in_progress":
+		return autoInProgress
+	case new == "in_progress" && old == "completed":
+		return autoCompleted
+	case new == "scheduled" && old == "completed":
+		return autoInProgress && autoCompleted
+	}
+	return false
+}
+
+// customizeDiffScheduledMaintenance validates the schedule and the status
+// lifecycle of a scheduled maintenance.
+func customizeDiffScheduledMaintenance(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	scheduledFor, errFor := time.Parse(time.RFC3339, d.Get("scheduled_for").(string))
+	scheduledUntil, errUntil := time.Parse(time.RFC3339, d.Get("scheduled_until").(string))
+	if errFor == nil && errUntil == nil && !scheduledUntil.After(scheduledFor) {
+		return fmt.Errorf("scheduled_until (%s) must be after scheduled_for (%s)", d.Get("scheduled_until"), d.Get("scheduled_for"))
+	}
+
+	if d.Id() != "" && d.HasChange("status") {
+		old, new := d.GetChange("status")
+		if maintenanceStatusRank(new.(string)) < maintenanceStatusRank(old.(string)) {
+			return fmt.Errorf("the status of a scheduled maintenance cannot go back from %s to %s", old, new)
+		}
+	}
+
+	return nil
+}
+
+func resourceScheduledMaintenanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	log.Printf("[INFO] Reading Status Page scheduled maintenance '%s'", d.Id())
+
+	incident, httpResp, err := statuspageClientV1.IncidentsApi.GetPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	if err != nil {
+		if HandleNotFoundError(d, httpResp, "scheduled maintenance") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get scheduled maintenance using Status Page API")
+	}
+
+	if incident.GetScheduledFor().IsZero() {
+		return diag.Errorf("incident %s is not a scheduled maintenance, use statuspage_incident instead", d.Id())
+	}
+
+	d.Set("name", incident.GetName())
+	d.Set("status", incident.GetStatus())
+	d.Set("impact_override", incident.GetImpactOverride())
+	d.Set("scheduled_for", formatTime(incident.GetScheduledFor()))
+	d.Set("scheduled_until", formatTime(incident.GetScheduledUntil()))
+	d.Set("scheduled_remind_prior", incident.GetScheduledRemindPrior())
+	d.Set("scheduled_auto_in_progress", incident.GetScheduledAutoInProgress())
+	d.Set("scheduled_auto_completed", incident.GetScheduledAutoCompleted())
+	d.Set("auto_transition_to_maintenance_state", incident.GetAutoTransitionToMaintenanceState())
+	d.Set("auto_transition_to_operational_state", incident.GetAutoTransitionToOperationalState())
+	d.Set("auto_transition_deliver_notifications_at_start", incident.GetAutoTransitionDeliverNotificationsAtStart())
+	d.Set("auto_transition_deliver_notifications_at_end", incident.GetAutoTransitionDeliverNotificationsAtEnd())
+	d.Set("shortlink", incident.GetShortlink())
+
+	componentIDs := make([]string, len(incident.GetComponents()))
+	for i, c := range incident.GetComponents() {
+		componentIDs[i] = c.GetId()
+	}
+	d.Set("component_ids", componentIDs)
+
+	return nil
+}
+
+func resourceScheduledMaintenanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	var incident sp.PostPagesPageIdIncidentsIncident
+	expandScheduledMaintenance(d, &incident, false)
+
+	o := *sp.NewPostPagesPageIdIncidents()
+	o.SetIncident(incident)
+
+	log.Printf("[INFO] Creating Status Page scheduled maintenance '%s'", d.Get("name").(string))
+	result, httpResp, err := statuspageClientV1.IncidentsApi.PostPagesPageIdIncidents(authV1, d.Get("page_id").(string)).PostPagesPageIdIncidents(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create scheduled maintenance using Status Page API")
+	}
+
+	d.SetId(result.GetId())
+
+	return resourceScheduledMaintenanceRead(ctx, d, m)
+}
+
+func resourceScheduledMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	var incident sp.PatchPagesPageIdIncidentsIncident
+	expandScheduledMaintenance(d, &incident, true)
+
+	o := *sp.NewPatchPagesPageIdIncidents()
+	o.SetIncident(incident)
+
+	log.Printf("[INFO] Update Status Page scheduled maintenance '%s'", d.Id())
+	_, httpResp, err := statuspageClientV1.IncidentsApi.PatchPagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdIncidents(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update scheduled maintenance using Status Page API")
+	}
+
+	return resourceScheduledMaintenanceRead(ctx, d, m)
+}
+
+func resourceScheduledMaintenanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	_, httpResp, err := statuspageClientV1.IncidentsApi.DeletePagesPageIdIncidentsIncidentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete scheduled maintenance using Status Page API")
+	}
+
+	return nil
+}
+
+func resourceScheduledMaintenanceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	if len(strings.Split(d.Id(), "/")) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Invalid resource format: %s. Please use 'page-id/incident-id'", d.Id())
+	}
+
+	pageID := strings.Split(d.Id(), "/")[0]
+	incidentID := strings.Split(d.Id(), "/")[1]
+
+	log.Printf("[INFO] Importing Scheduled Maintenance %s from Page %s", incidentID, pageID)
+
+	d.Set("page_id", pageID)
+	d.Set("deliver_notifications", true)
+	d.SetId(incidentID)
+
+	if diags := resourceScheduledMaintenanceRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceScheduledMaintenance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduledMaintenanceCreate,
+		ReadContext:   resourceScheduledMaintenanceRead,
+		UpdateContext: resourceScheduledMaintenanceUpdate,
+		DeleteContext: resourceScheduledMaintenanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScheduledMaintenanceImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffPageID,
+			customizeDiffScheduledMaintenance,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the page this maintenance belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this maintenance belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Maintenance Name",
+				Required:    true,
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The maintenance status, one of scheduled, in_progress, verifying and completed. It can only move forward",
+				ValidateFunc:     validation.StringInSlice(maintenanceStatuses, false),
+				Default:          "scheduled",
+				DiffSuppressFunc: suppressAutoTransitionedStatus,
+			},
+			"impact_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "value to override calculated impact value",
+				ValidateFunc: validation.StringInSlice([]string{"maintenance", "none", "critical", "major", "minor"}, false),
+				Default:      "maintenance",
+			},
+			"scheduled_for": {
+				Type:             schema.TypeString,
+				Description:      "the start of the maintenance, in RFC 3339 format",
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"scheduled_until": {
+				Type:             schema.TypeString,
+				Description:      "the end of the maintenance, in RFC 3339 format",
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"scheduled_remind_prior": {
+				Type:        schema.TypeBool,
+				Description: "Remind subscribers before the maintenance starts",
+				Optional:    true,
+				Default:     false,
+			},
+			"scheduled_auto_in_progress": {
+				Type:        schema.TypeBool,
+				Description: "Move the maintenance to in_progress at scheduled_for",
+				Optional:    true,
+				Default:     false,
+			},
+			"scheduled_auto_completed": {
+				Type:        schema.TypeBool,
+				Description: "Move the maintenance to completed at scheduled_until",
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_transition_to_maintenance_state": {
+				Type:        schema.TypeBool,
+				Description: "Move the affected components to under_maintenance when the maintenance starts",
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_transition_to_operational_state": {
+				Type:        schema.TypeBool,
+				Description: "Move the affected components to operational when the maintenance is completed",
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_transition_deliver_notifications_at_start": {
+				Type:        schema.TypeBool,
+				Description: "Notify subscribers when the maintenance automatically starts",
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_transition_deliver_notifications_at_end": {
+				Type:        schema.TypeBool,
+				Description: "Notify subscribers when the maintenance automatically completes",
+				Optional:    true,
+				Default:     false,
+			},
+			"deliver_notifications": {
+				Type:        schema.TypeBool,
+				Description: "Notify subscribers of the changes made by Terraform",
+				Optional:    true,
+				Default:     true,
+			},
+			"component_ids": {
+				Type:        schema.TypeSet,
+				Description: "the IDs of the components affected by the maintenance",
+				Optional:    true,
+				Set:         schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Description: "The message of the maintenance, posted as a new incident update when it changes",
+				Optional:    true,
+			},
+			"shortlink": {
+				Type:        schema.TypeString,
+				Description: "the short link to the maintenance",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package statuspage
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccStatuspageScheduledMaintenance_Basic(t *testing.T) {
+
+	rid := acctest.RandIntRange(1, 99)
+	start := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Hour)
+	scheduledFor := start.Format(time.RFC3339)
+	scheduledUntil := start.Add(2 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageIncidentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckScheduledMaintenanceConfig(rid, "scheduled", scheduledFor, scheduledUntil, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("statuspage_scheduled_maintenance.default", "id"),
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "status", "scheduled"),
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "scheduled_for", scheduledFor),
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "scheduled_remind_prior", "true"),
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "component_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckScheduledMaintenanceConfig(rid, "in_progress", scheduledFor, scheduledUntil, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "status", "in_progress"),
+				),
+			},
+			{
+				Config: testAccCheckScheduledMaintenanceConfig(rid, "in_progress", scheduledFor, scheduledUntil, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_scheduled_maintenance.default", "scheduled_remind_prior", "false"),
+				),
+			},
+			{
+				Config:             testAccCheckScheduledMaintenanceConfig(rid, "in_progress", scheduledFor, scheduledUntil, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config:      testAccCheckScheduledMaintenanceConfig(rid, "scheduled", scheduledFor, scheduledUntil, true),
+				ExpectError: regexp.MustCompile("cannot go back from in_progress to scheduled"),
+			},
+			{
+				Config:      testAccCheckScheduledMaintenanceConfig(rid, "in_progress", scheduledUntil, scheduledFor, true),
+				ExpectError: regexp.MustCompile("scheduled_until .* must be after scheduled_for"),
+			},
+			{
+				Config:                  testAccCheckScheduledMaintenanceConfig(rid, "in_progress", scheduledFor, scheduledUntil, false),
+				ResourceName:            "statuspage_scheduled_maintenance.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     pageID + "/",
+				ImportStateVerifyIgnore: []string{"page_name", "body", "deliver_notifications"},
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledMaintenanceConfig(rand int, status, scheduledFor, scheduledUntil string, remindPrior bool) string {
+	return fmt.Sprintf(`
+	variable "name" {
+		default = "tf-testacc-scheduled-maintenance-%d"
+	}
+	variable "pageid" {
+		default = "%s"
+	}
+	resource "statuspage_component" "my_component" {
+		page_id = var.pageid
+		name    = var.name
+	}
+	resource "statuspage_scheduled_maintenance" "default" {
+		page_id                = var.pageid
+		name                   = var.name
+		status                 = "%s"
+		scheduled_for          = "%s"
+		scheduled_until        = "%s"
+		scheduled_remind_prior = %t
+		deliver_notifications  = false
+		component_ids          = [statuspage_component.my_component.id]
+		body                   = "-"
+	}
+	`, rand, pageID, status, scheduledFor, scheduledUntil, remindPrior)
+}
+
+func TestUnitSuppressAutoTransitionedStatus(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		old            string
+		new            string
+		autoInProgress bool
+		autoCompleted  bool
+		want           bool
+	}{
+		{name: "started", old: "in_progress", new: "scheduled", autoInProgress: true, want: true},
+		{name: "startedManually", old: "in_progress", new: "scheduled", want: false},
+		{name: "completed", old: "completed", new: "in_progress", autoCompleted: true, want: true},
+		{name: "completedFromScheduled", old: "completed", new: "scheduled", autoInProgress: true, autoCompleted: true, want: true},
+		{name: "completedNotStarted", old: "completed", new: "scheduled", autoCompleted: true, want: false},
+		{name: "verifying", old: "verifying", new: "in_progress", autoInProgress: true, autoCompleted: true, want: false},
+		{name: "forward", old: "scheduled", new: "in_progress", autoInProgress: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceScheduledMaintenance().Schema, map[string]interface{}{
+				"scheduled_auto_in_progress": tt.autoInProgress,
+				"scheduled_auto_completed":   tt.autoCompleted,
+			})
+			if got := suppressAutoTransitionedStatus("status", tt.old, tt.new, d); got != tt.want {
+				t.Errorf("suppressAutoTransitionedStatus(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,27 +1,26 @@
 package statuspage
 
 import (
-	"log"
+	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
 )
 
-func resourceSubscriberRead(d *schema.ResourceData, m interface{}) error {
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	resp, _, err := statuspageClientV1.SubscribersApi.GetPagesPageIdSubscribersSubscriberId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	resp, httpResp, err := statuspageClientV1.SubscribersApi.GetPagesPageIdSubscribersSubscriberId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to get component groups using Status Page API")
-	}
-
-	if &resp == nil {
-		log.Printf("[INFO] Statuspage could not find subscriber with ID: %s\n", d.Id())
-		d.SetId("")
-		return nil
+		if HandleNotFoundError(d, httpResp, "subscriber") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get component groups using Status Page API")
 	}
 
 	d.Set("email", resp.GetEmail())
@@ -31,11 +30,15 @@ func resourceSubscriberRead(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func resourceSubscriberCreate(d *schema.ResourceData, m interface{}) error {
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
 	var subscriber sp.PostPagesPageIdSubscribersSubscriber
 
@@ -49,28 +52,28 @@ func resourceSubscriberCreate(d *schema.ResourceData, m interface{}) error {
 	o := *sp.NewPostPagesPageIdSubscribers()
 	o.SetSubscriber(subscriber)
 
-	result, _, err := statuspageClientV1.SubscribersApi.PostPagesPageIdSubscribers(authV1, d.Get("page_id").(string)).PostPagesPageIdSubscribers(o).Execute()
+	result, httpResp, err := statuspageClientV1.SubscribersApi.PostPagesPageIdSubscribers(authV1, d.Get("page_id").(string)).PostPagesPageIdSubscribers(o).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to create subscriber using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to create subscriber using Status Page API")
 	}
 
 	d.SetId(result.GetId())
 
-	return resourceSubscriberRead(d, m)
+	return resourceSubscriberRead(ctx, d, m)
 
 }
 
-func resourceSubscriberDelete(d *schema.ResourceData, m interface{}) error {
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
 	statuspageClientV1 := providerConf.StatuspageClientV1
-	authV1 := providerConf.AuthV1
+	authV1 := providerConf.AuthContext(ctx)
 
-	_, _, err := statuspageClientV1.SubscribersApi.DeletePagesPageIdSubscribersSubscriberId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	_, httpResp, err := statuspageClientV1.SubscribersApi.DeletePagesPageIdSubscribersSubscriberId(authV1, d.Get("page_id").(string), d.Id()).Execute()
 
 	if err != nil {
-		return TranslateClientErrorDiag(err, "failed to delete subscriber using Status Page API")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to delete subscriber using Status Page API")
 	}
 
 	return nil
@@ -79,16 +82,30 @@ func resourceSubscriberDelete(d *schema.ResourceData, m interface{}) error {
 
 func resourceSubscriber() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSubscriberCreate,
-		Read:   resourceSubscriberRead,
-		Delete: resourceSubscriberDelete,
+		CreateContext: resourceSubscriberCreate,
+		ReadContext:   resourceSubscriberRead,
+		DeleteContext: resourceSubscriberDelete,
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"page_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "the ID of the page this component belongs to",
+				Description: "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
 			"email": {
 				Type:        schema.TypeString,
 				Description: "the email address for creating Email and Webhook subscribers",
@@ -0,0 +1,320 @@
+package statuspage
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sp "github.com/sbecker59/statuspage-api-client-go/api/v1/statuspage"
+)
+
+// getThirdPartyComponent returns a component of a page found by ID, or by name
+// when id is empty, and fails unless it is a third-party component, so that a
+// component managed by a statuspage_component is never taken over.
+func getThirdPartyComponent(ctx context.Context, m interface{}, pageID string, id string, name string) (*sp.Component, diag.Diagnostics) {
+	var component *sp.Component
+	if id != "" {
+		providerConf := m.(*ProviderConfiguration)
+		res, httpResp, err := providerConf.StatuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(providerConf.AuthContext(ctx), pageID, id).Execute()
+		if err != nil {
+			return nil, TranslateClientErrorDiagnostics(err, httpResp, "failed to get third-party component using Status Page API")
+		}
+		component = &res
+	} else {
+		components, diags := listComponents(ctx, m, pageID)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var matches []sp.Component
+		var ids []string
+		for _, c := range components {
+			if !c.GetGroup() && c.GetName() == name {
+				matches = append(matches, c)
+				ids = append(ids, c.GetId())
+			}
+		}
+		if _, diags := uniqueID(ids, "component", name, pageID); diags.HasError() {
+			if len(ids) == 0 {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "third-party components must be added to the page from the Status Page UI",
+					Detail:   "The Status Page API cannot subscribe a page to a third-party component. Add it from the third-party components catalog, then apply again.",
+				})
+			}
+			return nil, diags
+		}
+		component = &matches[0]
+	}
+
+	if !component.GetThirdParty() {
+		return nil, diag.Errorf("component %s (%s) is not a third-party component, manage it with statuspage_component instead", component.GetId(), component.GetName())
+	}
+	return component, nil
+}
+
+func resourceThirdPartyComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	log.Printf("[INFO] Reading Status Page third-party component '%s'", d.Id())
+
+	component, httpResp, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).Execute()
+	if err != nil {
+		if HandleNotFoundError(d, httpResp, "third-party component") {
+			return nil
+		}
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to get third-party component using Status Page API")
+	}
+
+	d.Set("component_id", component.GetId())
+	// The name only identifies the component on creation, so that a rename
+	// by the third party does not replace the resource.
+	if d.Get("name").(string) == "" {
+		d.Set("name", component.GetName())
+	}
+	d.Set("description", component.GetDescription())
+	d.Set("status", component.GetStatus())
+	d.Set("group_id", component.GetGroupId())
+	d.Set("position", component.GetPosition())
+	d.Set("showcase", component.GetShowcase())
+	d.Set("only_show_if_degraded", component.GetOnlyShowIfDegraded())
+	d.Set("hidden", component.GetHidden())
+
+	return nil
+}
+
+func resourceThirdPartyComponentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	if err := resolvePageID(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	pageID := d.Get("page_id").(string)
+
+	existing, diags := getThirdPartyComponent(ctx, m, pageID, d.Get("component_id").(string), d.Get("name").(string))
+	if diags.HasError() {
+		return diags
+	}
+	componentID := existing.GetId()
+
+	d.SetId(componentID)
+
+	component, changed := thirdPartyComponentSettings(d, false)
+	if !changed {
+		return resourceThirdPartyComponentRead(ctx, d, m)
+	}
+
+	o := *sp.NewPatchPagesPageIdComponents()
+	o.SetComponent(component)
+
+	log.Printf("[INFO] Taking over Status Page third-party component '%s'", componentID)
+	_, httpResp, err := statuspageClientV1.ComponentsApi.PatchPagesPageIdComponentsComponentId(authV1, pageID, componentID).PatchPagesPageIdComponents(o).Execute()
+	if err != nil {
+		d.SetId("")
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update third-party component using Status Page API")
+	}
+
+	return resourceThirdPartyComponentRead(ctx, d, m)
+}
+
+func resourceThirdPartyComponentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	statuspageClientV1 := providerConf.StatuspageClientV1
+	authV1 := providerConf.AuthContext(ctx)
+
+	component, changed := thirdPartyComponentSettings(d, true)
+	if !changed {
+		return resourceThirdPartyComponentRead(ctx, d, m)
+	}
+
+	o := *sp.NewPatchPagesPageIdComponents()
+	o.SetComponent(component)
+
+	log.Printf("[INFO] Update Status Page third-party component '%s'", d.Id())
+	_, httpResp, err := statuspageClientV1.ComponentsApi.PatchPagesPageIdComponentsComponentId(authV1, d.Get("page_id").(string), d.Id()).PatchPagesPageIdComponents(o).Execute()
+	if err != nil {
+		return TranslateClientErrorDiagnostics(err, httpResp, "failed to update third-party component using Status Page API")
+	}
+
+	return resourceThirdPartyComponentRead(ctx, d, m)
+}
+
+func resourceThirdPartyComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Status Page third-party components cannot be added back by the API, removing component '%s' from state only", d.Id())
+	return nil
+}
+
+func resourceThirdPartyComponentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+
+	pageID, componentID, byName, err := parseImportID(d.Id(), "page-id/component-id")
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	var component *sp.Component
+	var diags diag.Diagnostics
+	if byName {
+		component, diags = getThirdPartyComponent(ctx, m, pageID, "", componentID)
+	} else {
+		component, diags = getThirdPartyComponent(ctx, m, pageID, componentID, "")
+	}
+	if diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	componentID = component.GetId()
+
+	log.Printf("[INFO] Importing Third-Party Component %s from Page %s", componentID, pageID)
+
+	d.Set("page_id", pageID)
+	d.SetId(componentID)
+
+	if diags := resourceThirdPartyComponentRead(ctx, d, m); diags.HasError() {
+		return []*schema.ResourceData{}, DiagnosticsError(diags)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// thirdPartyComponentSettings returns the display settings to send for a
+// third-party component: those set in the configuration on creation, and
+// those which changed on update. It reports whether there is any to send.
+func thirdPartyComponentSettings(d *schema.ResourceData, update bool) (sp.PostPagesPageIdComponentsComponent, bool) {
+	var component sp.PostPagesPageIdComponentsComponent
+	changed := false
+
+	rawConfig := d.GetRawConfig()
+	configured := func(k string) bool {
+		var ok bool
+		if update {
+			ok = d.HasChange(k)
+		} else {
+			ok = !rawConfig.IsNull() && !rawConfig.GetAttr(k).IsNull()
+		}
+		changed = changed || ok
+		return ok
+	}
+
+	if configured("group_id") {
+		component.SetGroupId(d.Get("group_id").(string))
+	}
+	if configured("position") {
+		component.SetPosition(int32(d.Get("position").(int)))
+	}
+	if configured("showcase") {
+		component.SetShowcase(d.Get("showcase").(bool))
+	}
+	if configured("only_show_if_degraded") {
+		component.SetOnlyShowIfDegraded(d.Get("only_show_if_degraded").(bool))
+	}
+	if configured("hidden") {
+		component.SetHidden(d.Get("hidden").(bool))
+	}
+
+	return component, changed
+}
+
+// resourceThirdPartyComponent takes over a third-party component already added
+// to the page from the catalog of the Status Page UI, as the API cannot add
+// one. It manages how the component is displayed through the component
+// endpoints. Destroying the resource leaves the component on the page, since
+// it could not be added back.
+func resourceThirdPartyComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceThirdPartyComponentCreate,
+		ReadContext:   resourceThirdPartyComponentRead,
+		UpdateContext: resourceThirdPartyComponentUpdate,
+		DeleteContext: resourceThirdPartyComponentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceThirdPartyComponentImport,
+		},
+		CustomizeDiff: customizeDiffPageID,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the page this component belongs to. Defaults to the page_id of the provider",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"page_name": {
+				Type:          schema.TypeString,
+				Description:   "the name of the page this component belongs to, as an alternative to page_id",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"page_id"},
+			},
+			"component_id": {
+				Type:         schema.TypeString,
+				Description:  "the ID of the third-party component, once added to the page from the Status Page UI",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"component_id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "the name of the third-party component on the page, as an alternative to component_id",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"component_id", "name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "the ID of the component group this component belongs to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Description:  "the order of the component on the page, or in its group",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"showcase": {
+				Type:        schema.TypeBool,
+				Description: "Should this component be showcased",
+				Optional:    true,
+				Computed:    true,
+			},
+			"only_show_if_degraded": {
+				Type:        schema.TypeBool,
+				Description: "Should this component be hidden unless it is degraded",
+				Optional:    true,
+				Computed:    true,
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Description: "Should this component be hidden from the page",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "the description of the component, set by the third party",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "the status of the component, set by the third party",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccStatuspageThirdPartyComponent_Basic(t *testing.T) {
+
+	if thirdPartyComponentName == "" {
+		t.Skip("STATUSPAGE_THIRD_PARTY_COMPONENT_NAME must be set to a third-party component added to the page")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStatuspageThirdPartyComponentKept,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckThirdPartyComponentConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("statuspage_third_party_component.default", "component_id"),
+					resource.TestCheckResourceAttrSet("statuspage_third_party_component.default", "status"),
+					resource.TestCheckResourceAttr("statuspage_third_party_component.default", "only_show_if_degraded", "true"),
+				),
+			},
+			{
+				Config: testAccCheckThirdPartyComponentConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("statuspage_third_party_component.default", "only_show_if_degraded", "false"),
+					resource.TestCheckResourceAttr("statuspage_third_party_component.default", "hidden", "true"),
+				),
+			},
+			{
+				ResourceName:      "statuspage_third_party_component.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(ts *terraform.State) (string, error) {
+					rs := ts.RootModule().Resources["statuspage_third_party_component.default"]
+					return fmt.Sprintf("%s/%s", pageID, rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckThirdPartyComponentConfig(onlyShowIfDegraded bool) string {
+	return fmt.Sprintf(`
+	resource "statuspage_third_party_component" "default" {
+		page_id = "%s"
+		name = "%s"
+		only_show_if_degraded = %t
+		hidden = %t
+	}
+	`, pageID, thirdPartyComponentName, onlyShowIfDegraded, !onlyShowIfDegraded)
+}
+
+// testAccCheckStatuspageThirdPartyComponentKept checks that destroying the
+// resource left the third-party component on the page.
+func testAccCheckStatuspageThirdPartyComponentKept(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ProviderConfiguration)
+	statuspageClientV1 := conn.StatuspageClientV1
+	authV1 := conn.AuthContext(context.Background())
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "statuspage_third_party_component" {
+			continue
+		}
+		_, _, err := statuspageClientV1.ComponentsApi.GetPagesPageIdComponentsComponentId(authV1, pageID, r.Primary.ID).Execute()
+		if err != nil {
+			return TranslateClientErrorDiag(err, "third-party component was removed from the page")
+		}
+	}
+	return nil
+}